@@ -0,0 +1,121 @@
+package dvb
+
+// Mobility restriction profiles of the trip planner, see MobilitySettings
+const (
+	mobilityRestrictionHigh       = "High"
+	mobilityRestrictionIndividual = "Individual"
+	entranceNoStep                = "NoStep"
+)
+
+// AccessibilityParams contains the mobility restrictions for barrier-free journeys.
+// They are mapped to the API's mobilitySettings, see RouteSettings.
+type AccessibilityParams struct {
+	// NoStairs avoids stairs on footpaths and transfers
+	NoStairs bool
+
+	// NoEscalators avoids escalators on footpaths and transfers
+	NoEscalators bool
+
+	// LowFloorOnly restricts the connections to vehicles with step-free entrances
+	LowFloorOnly bool
+
+	// Wheelchair plans journeys usable with a wheelchair. This implies all other restrictions.
+	Wheelchair bool
+}
+
+// mobilitySettings maps the restrictions to the API's mobilitySettings. Fields
+// set in explicit take precedence. Returns explicit if no restriction is set.
+func (a *AccessibilityParams) mobilitySettings(explicit *MobilitySettings) *MobilitySettings {
+	if a == nil || !(a.NoStairs || a.NoEscalators || a.LowFloorOnly || a.Wheelchair) {
+		return explicit
+	}
+
+	var settings MobilitySettings
+	if a.Wheelchair {
+		settings.MobilityRestriction = String(mobilityRestrictionHigh)
+		settings.SolidStairs = Bool(false)
+		settings.Escalators = Bool(false)
+		settings.Entrance = String(entranceNoStep)
+	} else {
+		settings.MobilityRestriction = String(mobilityRestrictionIndividual)
+		settings.SolidStairs = Bool(!a.NoStairs)
+		settings.Escalators = Bool(!a.NoEscalators)
+		if a.LowFloorOnly {
+			settings.Entrance = String(entranceNoStep)
+		}
+	}
+
+	if explicit != nil {
+		if explicit.MobilityRestriction != nil {
+			settings.MobilityRestriction = explicit.MobilityRestriction
+		}
+		if explicit.SolidStairs != nil {
+			settings.SolidStairs = explicit.SolidStairs
+		}
+		if explicit.Escalators != nil {
+			settings.Escalators = explicit.Escalators
+		}
+		if explicit.LeastChange != nil {
+			settings.LeastChange = explicit.LeastChange
+		}
+		if explicit.Entrance != nil {
+			settings.Entrance = explicit.Entrance
+		}
+	}
+	return &settings
+}
+
+// StopAccessibility describes the accessibility of a stop. Each attribute is nil
+// if the API does not report it for the stop.
+type StopAccessibility struct {
+	// StepFree is true if the platform can be reached without steps
+	StepFree *bool `json:"StepFree,omitempty"`
+
+	// Elevator is true if the platform is served by an elevator
+	Elevator *bool `json:"Elevator,omitempty"`
+
+	// TactilePaving is true if the platform has a tactile guidance system
+	TactilePaving *bool `json:"TactilePaving,omitempty"`
+}
+
+// Accessible reports whether the leg is known to be step-free: the vehicle is
+// low-floor and both the boarding and the alighting stop are step-free. The
+// second return value is false if the API did not report all attributes.
+func (p *PartialRoute) Accessible() (accessible bool, known bool) {
+	if !p.isTransit() {
+		return true, true
+	}
+
+	accessible, known = true, true
+	check := func(value *bool) {
+		if value == nil {
+			known = false
+		} else if !*value {
+			accessible = false
+		}
+	}
+
+	check(p.Mot.LowFloor)
+	for _, stop := range []*RegularStop{p.BoardingStop(), p.AlightingStop()} {
+		if stop.Accessibility == nil {
+			known = false
+			continue
+		}
+		check(stop.Accessibility.StepFree)
+	}
+	return accessible, known
+}
+
+// Accessible reports whether all legs of the route are known to be step-free,
+// see PartialRoute.Accessible. The second return value is false if the API did
+// not report all attributes; a leg known to be inaccessible still makes the
+// route inaccessible.
+func (r *Route) Accessible() (accessible bool, known bool) {
+	accessible, known = true, true
+	for leg := range r.Legs() {
+		legAccessible, legKnown := leg.Accessible()
+		accessible = accessible && legAccessible
+		known = known && legKnown
+	}
+	return accessible, known
+}
@@ -0,0 +1,161 @@
+package dvb
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+	"strings"
+)
+
+// AddressSearchParams contains the parameters for resolving a position to an address.
+type AddressSearchParams struct {
+	// Latitude and Longitude are the WGS84 position to resolve in degrees,
+	// e.g. from a phone's location service. These are required.
+	Latitude  float64
+	Longitude float64
+
+	// Limit restricts the maximum number of points of interest returned.
+	// Optional parameter, defaults to 10.
+	Limit *int
+}
+
+// AddressSearchResult is the address and the points of interest around a position
+type AddressSearchResult struct {
+	// Address is the address nearest to the position, nil if the API returned none
+	Address *Address
+
+	// POIs are the points of interest around the position, nearest first
+	POIs []POI
+}
+
+// Address is a street address resolved by the point finder
+type Address struct {
+	Point Point
+
+	// Street is the street name, e.g. "Prager Straße"
+	Street string
+
+	// HouseNumber is the house number, empty if the API returned the street only
+	HouseNumber string
+
+	// PostalCode is the postal code, empty if unknown
+	PostalCode string
+
+	// Place is the city, e.g. "Dresden"
+	Place string
+
+	// Distance is the straight-line distance in meters between the position and the address
+	Distance float64
+}
+
+// POI is a point of interest along with its distance from the searched position
+type POI struct {
+	Point Point
+
+	// Distance is the straight-line distance in meters between the position and the POI
+	Distance float64
+}
+
+// AddressSearch resolves a WGS84 position to the nearest address and the points
+// of interest around it (reverse geocoding). The result's Address can be used as
+// origin of GetRoute, e.g. to plan a route from the current location.
+//
+// Parameters:
+//   - ctx: Context for the request, allowing for cancellation and timeouts
+//   - options: Parameters including the required position and an optional limit
+//   - requestOpts: Optional per-request overrides like headers or a timeout, see RequestOption
+//
+// Returns:
+//   - *AddressSearchResult: The nearest address, if any, and the points of interest
+//   - error: Returns an error if the position is invalid or if the API request fails
+//
+// Example usage:
+//
+//	result, err := client.AddressSearch(ctx, &AddressSearchParams{
+//		Latitude:  51.0405,
+//		Longitude: 13.7318,
+//	})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	if result.Address != nil {
+//		response, err := client.GetRoute(ctx, &GetRouteParams{
+//			Origin:      result.Address.Point.Id,
+//			Destination: "33000037",
+//		})
+//		...
+//	}
+func (c *Client) AddressSearch(ctx context.Context, options *AddressSearchParams, requestOpts ...RequestOption) (*AddressSearchResult, error) {
+	ctx, cancel := withRequestOptions(ctx, requestOpts)
+	defer cancel()
+
+	if options == nil {
+		return nil, errors.New("latitude and longitude can not be empty")
+	}
+	query, x, y, err := coordQuery(options.Latitude, options.Longitude)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := 10
+	if options.Limit != nil && *options.Limit > 0 {
+		limit = *options.Limit
+	}
+
+	response, err := c.GetPoint(ctx, &GetPointParams{
+		Query: query,
+		Limit: Int(limit + 1),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	distance := func(point Point) float64 {
+		if point.X == 0 || point.Y == 0 {
+			return math.Inf(1)
+		}
+		return math.Hypot(float64(point.X)-x, float64(point.Y)-y)
+	}
+
+	result := &AddressSearchResult{}
+	for _, point := range response.Points.OnlyAddresses() {
+		address := parseAddress(point)
+		address.Distance = distance(point)
+		if result.Address == nil || address.Distance < result.Address.Distance {
+			result.Address = &address
+		}
+	}
+
+	for _, point := range response.Points.OnlyPOIs() {
+		result.POIs = append(result.POIs, POI{Point: point, Distance: distance(point)})
+	}
+	sort.SliceStable(result.POIs, func(i, j int) bool {
+		return result.POIs[i].Distance < result.POIs[j].Distance
+	})
+	if len(result.POIs) > limit {
+		result.POIs = result.POIs[:limit]
+	}
+	return result, nil
+}
+
+// parseAddress extracts the address parts of an address point. Street IDs have
+// the form "streetID:id:houseNumber:municipality:-1:street:place:...:postalCode:...";
+// if the ID can't be parsed, the point's name and place are used.
+func parseAddress(point Point) Address {
+	address := Address{Point: point, Street: point.Name, Place: point.Place}
+
+	fields := strings.Split(point.Id, ":")
+	if len(fields) < 11 || fields[0] != "streetID" {
+		return address
+	}
+	if fields[5] != "" {
+		address.Street = fields[5]
+	}
+	address.HouseNumber = fields[2]
+	if fields[6] != "" {
+		address.Place = fields[6]
+	}
+	address.PostalCode = fields[10]
+	return address
+}
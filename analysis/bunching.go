@@ -0,0 +1,133 @@
+package analysis
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/niclaszll/dvb-go"
+)
+
+// BunchingEvent reports two departures of the same line and direction at a stop
+// running closer together than a fraction of the scheduled headway
+type BunchingEvent struct {
+	StopId    string
+	Line      string
+	Direction string
+
+	// Leader and Follower are the bunched departures in order of their real-time departure
+	Leader   dvb.Departure
+	Follower dvb.Departure
+
+	// Gap is the real-time interval between both departures
+	Gap time.Duration
+
+	// ScheduledHeadway is the headway the gap was compared against
+	ScheduledHeadway time.Duration
+}
+
+// HeadwayFunc returns the scheduled headway of a line and direction at time t,
+// or 0 if it is unknown
+type HeadwayFunc func(line, direction string, t time.Time) time.Duration
+
+// BunchingDetector detects vehicle bunching in consecutive departure snapshots.
+// Feed it the departures of every poll (e.g. from a line or stop monitor); each
+// bunched pair is reported only once.
+type BunchingDetector struct {
+	headway HeadwayFunc
+
+	// Threshold is the fraction of the scheduled headway below which two departures
+	// count as bunched (defaults to 0.25)
+	Threshold float64
+
+	mu       sync.Mutex
+	reported map[[2]string]time.Time
+}
+
+// NewBunchingDetector creates a detector comparing gaps against the given scheduled headways
+func NewBunchingDetector(headway HeadwayFunc) *BunchingDetector {
+	return &BunchingDetector{
+		headway:   headway,
+		Threshold: 0.25,
+		reported:  make(map[[2]string]time.Time),
+	}
+}
+
+// HeadwaysFrom builds a HeadwayFunc from precomputed headway statistics,
+// using the median headway of the matching band
+func HeadwaysFrom(headways []Headways, bands []Band) HeadwayFunc {
+	type key struct{ line, direction, band string }
+	index := make(map[key]time.Duration, len(headways))
+	for _, h := range headways {
+		index[key{h.Line, h.Direction, h.Band}] = h.Median
+	}
+	return func(line, direction string, t time.Time) time.Duration {
+		return index[key{line, direction, bandOf(t, bands)}]
+	}
+}
+
+// Observe checks a snapshot of departures at a stop and returns newly detected bunching events
+func (d *BunchingDetector) Observe(stopId string, departures []dvb.Departure) []BunchingEvent {
+	type timed struct {
+		departure dvb.Departure
+		at        time.Time
+	}
+	groups := make(map[[2]string][]timed)
+	for _, departure := range departures {
+		at := departure.RealTime.Time
+		if at.IsZero() {
+			at = departure.ScheduledTime.Time
+		}
+		if at.IsZero() {
+			continue
+		}
+		key := [2]string{departure.LineName, departure.Direction}
+		groups[key] = append(groups[key], timed{departure, at})
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var events []BunchingEvent
+	for key, group := range groups {
+		sort.Slice(group, func(i, j int) bool { return group[i].at.Before(group[j].at) })
+		for i := 0; i+1 < len(group); i++ {
+			leader, follower := group[i], group[i+1]
+			headway := d.headway(key[0], key[1], leader.at)
+			if headway <= 0 {
+				continue
+			}
+
+			gap := follower.at.Sub(leader.at)
+			if gap >= time.Duration(float64(headway)*d.Threshold) {
+				continue
+			}
+
+			pair := [2]string{stopId + "/" + leader.departure.Id, follower.departure.Id}
+			if _, ok := d.reported[pair]; ok {
+				continue
+			}
+			d.reported[pair] = follower.at
+
+			events = append(events, BunchingEvent{
+				StopId:           stopId,
+				Line:             key[0],
+				Direction:        key[1],
+				Leader:           leader.departure,
+				Follower:         follower.departure,
+				Gap:              gap,
+				ScheduledHeadway: headway,
+			})
+		}
+	}
+
+	// Forget pairs that have departed long ago to keep memory bounded
+	cutoff := time.Now().Add(-time.Hour)
+	for pair, at := range d.reported {
+		if at.Before(cutoff) {
+			delete(d.reported, pair)
+		}
+	}
+
+	return events
+}
@@ -0,0 +1,213 @@
+// Package analysis provides service-quality analysis on top of dvb responses and
+// static GTFS data, such as headway statistics and bunching detection.
+package analysis
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/niclaszll/dvb-go"
+	"github.com/niclaszll/dvb-go/gtfs"
+)
+
+// Band is a time-of-day range used to group headways, e.g. the morning peak
+type Band struct {
+	Name string
+
+	// Start and End are offsets from midnight; End is exclusive
+	Start time.Duration
+	End   time.Duration
+}
+
+// DefaultBands splits the day into typical service periods
+var DefaultBands = []Band{
+	{Name: "early", Start: 0, End: 6 * time.Hour},
+	{Name: "morning-peak", Start: 6 * time.Hour, End: 9 * time.Hour},
+	{Name: "daytime", Start: 9 * time.Hour, End: 15 * time.Hour},
+	{Name: "afternoon-peak", Start: 15 * time.Hour, End: 19 * time.Hour},
+	{Name: "evening", Start: 19 * time.Hour, End: 24 * time.Hour},
+}
+
+// Observation is a single departure of a line at a stop
+type Observation struct {
+	StopId    string
+	Line      string
+	Direction string
+	Time      time.Time
+}
+
+// Headways summarizes the intervals between consecutive departures of a line and
+// direction within a time band
+type Headways struct {
+	Line      string
+	Direction string
+	Band      string
+
+	// Count is the number of intervals the statistics are based on
+	Count int
+
+	Mean   time.Duration
+	Median time.Duration
+	Min    time.Duration
+	Max    time.Duration
+}
+
+// FromDepartures converts a MonitorStop response into observations. If realTime is true,
+// the real-time departure is used where available, otherwise the scheduled time.
+func FromDepartures(stopId string, departures []dvb.Departure, realTime bool) []Observation {
+	var observations []Observation
+	for _, departure := range departures {
+		t := departure.ScheduledTime.Time
+		if realTime && !departure.RealTime.IsZero() {
+			t = departure.RealTime.Time
+		}
+		if t.IsZero() {
+			continue
+		}
+		observations = append(observations, Observation{
+			StopId:    stopId,
+			Line:      departure.LineName,
+			Direction: departure.Direction,
+			Time:      t,
+		})
+	}
+	return observations
+}
+
+// FromFeed returns the scheduled departures at a stop on the given date as observations
+func FromFeed(feed *gtfs.Feed, stopId string, date time.Time) []Observation {
+	start := feed.ServiceDay(date)
+	response, err := feed.ScheduledDepartures(stopId, start, math.MaxInt32)
+	if err != nil {
+		return nil
+	}
+
+	end := start.Add(24 * time.Hour)
+	var observations []Observation
+	for _, observation := range FromDepartures(stopId, response.Departures, false) {
+		if observation.Time.Before(end) {
+			observations = append(observations, observation)
+		}
+	}
+	return observations
+}
+
+// Compute calculates headway statistics per line, direction and band. Intervals are
+// measured between consecutive departures at the same stop and assigned to the band
+// the earlier departure falls into.
+func Compute(observations []Observation, bands []Band) []Headways {
+	type key struct{ stop, line, direction string }
+	groups := make(map[key][]time.Time)
+	for _, o := range observations {
+		k := key{o.StopId, o.Line, o.Direction}
+		groups[k] = append(groups[k], o.Time)
+	}
+
+	type resultKey struct{ line, direction, band string }
+	intervals := make(map[resultKey][]time.Duration)
+	for k, times := range groups {
+		sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+		for i := 0; i+1 < len(times); i++ {
+			band := bandOf(times[i], bands)
+			if band == "" {
+				continue
+			}
+			rk := resultKey{k.line, k.direction, band}
+			intervals[rk] = append(intervals[rk], times[i+1].Sub(times[i]))
+		}
+	}
+
+	var result []Headways
+	for k, values := range intervals {
+		result = append(result, summarize(k.line, k.direction, k.band, values))
+	}
+	sort.Slice(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		if a.Direction != b.Direction {
+			return a.Direction < b.Direction
+		}
+		return a.Band < b.Band
+	})
+	return result
+}
+
+// Comparison contrasts scheduled and observed headways of a line, direction and band
+type Comparison struct {
+	Line      string
+	Direction string
+	Band      string
+
+	Scheduled Headways
+	Observed  Headways
+
+	// Ratio is the observed mean headway divided by the scheduled one.
+	// Values above 1 mean vehicles came less frequently than planned.
+	Ratio float64
+}
+
+// Compare matches scheduled and observed headways by line, direction and band.
+// Groups missing on either side are omitted.
+func Compare(scheduled, observed []Headways) []Comparison {
+	type key struct{ line, direction, band string }
+	index := make(map[key]Headways, len(observed))
+	for _, h := range observed {
+		index[key{h.Line, h.Direction, h.Band}] = h
+	}
+
+	var result []Comparison
+	for _, s := range scheduled {
+		o, ok := index[key{s.Line, s.Direction, s.Band}]
+		if !ok || s.Mean == 0 {
+			continue
+		}
+		result = append(result, Comparison{
+			Line:      s.Line,
+			Direction: s.Direction,
+			Band:      s.Band,
+			Scheduled: s,
+			Observed:  o,
+			Ratio:     float64(o.Mean) / float64(s.Mean),
+		})
+	}
+	return result
+}
+
+// bandOf returns the name of the band t falls into, or "" if none matches
+func bandOf(t time.Time, bands []Band) string {
+	offset := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second
+	for _, band := range bands {
+		if offset >= band.Start && offset < band.End {
+			return band.Name
+		}
+	}
+	return ""
+}
+
+func summarize(line, direction, band string, values []time.Duration) Headways {
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+
+	var total time.Duration
+	for _, v := range values {
+		total += v
+	}
+
+	median := values[len(values)/2]
+	if len(values)%2 == 0 {
+		median = (values[len(values)/2-1] + values[len(values)/2]) / 2
+	}
+
+	return Headways{
+		Line:      line,
+		Direction: direction,
+		Band:      band,
+		Count:     len(values),
+		Mean:      total / time.Duration(len(values)),
+		Median:    median,
+		Min:       values[0],
+		Max:       values[len(values)-1],
+	}
+}
@@ -0,0 +1,198 @@
+package analysis
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Punctuality summarizes the delays of a set of departures
+type Punctuality struct {
+	// Key is the stop ID or line name the summary belongs to ("" for the total)
+	Key string `json:"key,omitempty"`
+
+	Departures int `json:"departures"`
+	Cancelled  int `json:"cancelled"`
+
+	// OnTimePercent is the share of non-cancelled departures within the on-time threshold
+	OnTimePercent float64 `json:"onTimePercent"`
+
+	AverageDelay time.Duration `json:"averageDelay"`
+	MaxDelay     time.Duration `json:"maxDelay"`
+}
+
+// Report is a punctuality summary for a period
+type Report struct {
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+
+	Total Punctuality `json:"total"`
+
+	// WorstStops and WorstLines are ordered by average delay, worst first
+	WorstStops []Punctuality `json:"worstStops"`
+	WorstLines []Punctuality `json:"worstLines"`
+}
+
+// ReportOptions controls report generation
+type ReportOptions struct {
+	// OnTimeThreshold is the maximum delay still counted as on time (defaults to 3 minutes)
+	OnTimeThreshold time.Duration
+
+	// Worst limits the number of stops and lines listed (defaults to 10)
+	Worst int
+}
+
+// Daily returns the period of the calendar day containing t
+func Daily(t time.Time) (from, to time.Time) {
+	from = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	return from, from.AddDate(0, 0, 1)
+}
+
+// Weekly returns the period of the ISO week (Monday to Sunday) containing t
+func Weekly(t time.Time) (from, to time.Time) {
+	from, _ = Daily(t)
+	from = from.AddDate(0, 0, -((int(from.Weekday()) + 6) % 7))
+	return from, from.AddDate(0, 0, 7)
+}
+
+// GenerateReport summarizes the observations of store scheduled within [from, to)
+func GenerateReport(store ObservationStore, from, to time.Time, opts ReportOptions) (*Report, error) {
+	if opts.OnTimeThreshold == 0 {
+		opts.OnTimeThreshold = 3 * time.Minute
+	}
+	if opts.Worst == 0 {
+		opts.Worst = 10
+	}
+
+	observations, err := store.Range(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	byStop := make(map[string][]DelayObservation)
+	byLine := make(map[string][]DelayObservation)
+	for _, o := range observations {
+		byStop[o.StopId] = append(byStop[o.StopId], o)
+		byLine[o.Line] = append(byLine[o.Line], o)
+	}
+
+	return &Report{
+		From:       from,
+		To:         to,
+		Total:      punctuality("", observations, opts.OnTimeThreshold),
+		WorstStops: worst(byStop, opts),
+		WorstLines: worst(byLine, opts),
+	}, nil
+}
+
+func worst(groups map[string][]DelayObservation, opts ReportOptions) []Punctuality {
+	var result []Punctuality
+	for key, observations := range groups {
+		result = append(result, punctuality(key, observations, opts.OnTimeThreshold))
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].AverageDelay != result[j].AverageDelay {
+			return result[i].AverageDelay > result[j].AverageDelay
+		}
+		return result[i].Key < result[j].Key
+	})
+	if len(result) > opts.Worst {
+		result = result[:opts.Worst]
+	}
+	return result
+}
+
+func punctuality(key string, observations []DelayObservation, threshold time.Duration) Punctuality {
+	p := Punctuality{Key: key, Departures: len(observations)}
+
+	var total time.Duration
+	onTime, running := 0, 0
+	for _, o := range observations {
+		if o.Cancelled {
+			p.Cancelled++
+			continue
+		}
+		running++
+		delay := max(o.Delay(), 0)
+		total += delay
+		p.MaxDelay = max(p.MaxDelay, delay)
+		if delay <= threshold {
+			onTime++
+		}
+	}
+	if running > 0 {
+		p.AverageDelay = total / time.Duration(running)
+		p.OnTimePercent = float64(onTime) * 100 / float64(running)
+	}
+	return p
+}
+
+// WriteJSON writes the report as indented JSON
+func (r *Report) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
+
+// WriteMarkdown writes the report as a Markdown document with tables
+func (r *Report) WriteMarkdown(w io.Writer) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Punctuality report %s – %s\n\n", r.From.Format("2006-01-02"), r.To.Add(-time.Second).Format("2006-01-02"))
+	fmt.Fprintf(&b, "- Departures: %d\n", r.Total.Departures)
+	fmt.Fprintf(&b, "- Cancelled: %d\n", r.Total.Cancelled)
+	fmt.Fprintf(&b, "- On time: %.1f%%\n", r.Total.OnTimePercent)
+	fmt.Fprintf(&b, "- Average delay: %s\n", r.Total.AverageDelay.Round(time.Second))
+
+	for _, section := range []struct {
+		title  string
+		column string
+		rows   []Punctuality
+	}{
+		{"Worst stops", "Stop", r.WorstStops},
+		{"Worst lines", "Line", r.WorstLines},
+	} {
+		fmt.Fprintf(&b, "\n## %s\n\n", section.title)
+		fmt.Fprintf(&b, "| %s | Departures | Cancelled | On time | Average delay | Max delay |\n", section.column)
+		b.WriteString("|---|---:|---:|---:|---:|---:|\n")
+		for _, row := range section.rows {
+			fmt.Fprintf(&b, "| %s | %d | %d | %.1f%% | %s | %s |\n", row.Key, row.Departures, row.Cancelled,
+				row.OnTimePercent, row.AverageDelay.Round(time.Second), row.MaxDelay.Round(time.Second))
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// WriteCSV writes one row per summary (total, stops, lines) with delays in seconds
+func (r *Report) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	writer.Write([]string{"scope", "key", "departures", "cancelled", "on_time_percent", "average_delay_s", "max_delay_s"})
+
+	write := func(scope string, p Punctuality) {
+		writer.Write([]string{
+			scope,
+			p.Key,
+			strconv.Itoa(p.Departures),
+			strconv.Itoa(p.Cancelled),
+			strconv.FormatFloat(p.OnTimePercent, 'f', 1, 64),
+			strconv.FormatFloat(p.AverageDelay.Seconds(), 'f', 0, 64),
+			strconv.FormatFloat(p.MaxDelay.Seconds(), 'f', 0, 64),
+		})
+	}
+	write("total", r.Total)
+	for _, p := range r.WorstStops {
+		write("stop", p)
+	}
+	for _, p := range r.WorstLines {
+		write("line", p)
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
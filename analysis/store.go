@@ -0,0 +1,111 @@
+package analysis
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/niclaszll/dvb-go"
+)
+
+// DelayObservation is the last known state of a departure at a stop
+type DelayObservation struct {
+	DepartureId string
+	StopId      string
+	Line        string
+	Direction   string
+
+	Scheduled time.Time
+
+	// Actual is the real-time departure, or equal to Scheduled if no real-time data was available
+	Actual time.Time
+
+	Cancelled bool
+}
+
+// Delay returns the difference between the actual and scheduled departure
+func (o DelayObservation) Delay() time.Duration {
+	return o.Actual.Sub(o.Scheduled)
+}
+
+// ObservationStore persists delay observations for later reporting
+type ObservationStore interface {
+	// Put stores or replaces the observation identified by its stop and departure ID
+	Put(observation DelayObservation) error
+
+	// Range returns all observations scheduled within [from, to)
+	Range(from, to time.Time) ([]DelayObservation, error)
+}
+
+// Recorder turns departure snapshots into delay observations. Each departure is
+// overwritten with every snapshot, so the store keeps the latest known delay.
+type Recorder struct {
+	store ObservationStore
+}
+
+// NewRecorder creates a Recorder writing to store
+func NewRecorder(store ObservationStore) *Recorder {
+	return &Recorder{store: store}
+}
+
+// Record stores the departures of a MonitorStop snapshot
+func (r *Recorder) Record(stopId string, departures []dvb.Departure) error {
+	for _, departure := range departures {
+		scheduled := departure.ScheduledTime.Time
+		if scheduled.IsZero() {
+			continue
+		}
+		actual := scheduled
+		if !departure.RealTime.IsZero() {
+			actual = departure.RealTime.Time
+		}
+
+		err := r.store.Put(DelayObservation{
+			DepartureId: departure.Id,
+			StopId:      stopId,
+			Line:        departure.LineName,
+			Direction:   departure.Direction,
+			Scheduled:   scheduled,
+			Actual:      actual,
+			Cancelled:   departure.IsCancelled(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MemoryStore is an in-memory ObservationStore
+type MemoryStore struct {
+	mu           sync.Mutex
+	observations map[[2]string]DelayObservation
+}
+
+// NewMemoryStore creates an empty MemoryStore
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{observations: make(map[[2]string]DelayObservation)}
+}
+
+func (s *MemoryStore) Put(observation DelayObservation) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.observations[[2]string{observation.StopId, observation.DepartureId}] = observation
+	return nil
+}
+
+func (s *MemoryStore) Range(from, to time.Time) ([]DelayObservation, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var result []DelayObservation
+	for _, observation := range s.observations {
+		if !observation.Scheduled.Before(from) && observation.Scheduled.Before(to) {
+			result = append(result, observation)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Scheduled.Before(result[j].Scheduled)
+	})
+	return result, nil
+}
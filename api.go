@@ -0,0 +1,19 @@
+package dvb
+
+import "context"
+
+// API is the set of API calls implemented by *Client. Code depending on API instead
+// of *Client can be unit tested without HTTP, e.g. using the fake in the dvbmock package.
+type API interface {
+	MonitorStop(ctx context.Context, options *MonitorStopParams, requestOpts ...RequestOption) (*MonitorStopResponse, error)
+	GetRoute(ctx context.Context, options *GetRouteParams, requestOpts ...RequestOption) (*GetRouteResponse, error)
+	GetLines(ctx context.Context, options *GetLinesParams, requestOpts ...RequestOption) (*GetLinesResponse, error)
+	GetPoint(ctx context.Context, options *GetPointParams, requestOpts ...RequestOption) (*GetPointResponse, error)
+	GetTripDetails(ctx context.Context, options *GetTripDetailsParams, requestOpts ...RequestOption) (*GetTripDetailsResponse, error)
+	GetRouteChanges(ctx context.Context, options *GetRouteChangesParams, requestOpts ...RequestOption) (*GetRouteChangesResponse, error)
+	GetTimetable(ctx context.Context, options *GetTimetableParams, requestOpts ...RequestOption) (*GetTimetableResponse, error)
+	GetEarlierRoutes(ctx context.Context, sessionId string, requestOpts ...RequestOption) (*GetRouteResponse, error)
+	GetLaterRoutes(ctx context.Context, sessionId string, requestOpts ...RequestOption) (*GetRouteResponse, error)
+}
+
+var _ API = (*Client)(nil)
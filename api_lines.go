@@ -3,8 +3,10 @@ package dvb
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/url"
+	"slices"
 )
 
 // GetLinesParams contains the parameters for retrieving available public transport lines for a stop.
@@ -12,11 +14,17 @@ import (
 type GetLinesParams struct {
 	// StopId is the unique identifier for the stop. This is required and cannot be empty.
 	// Use the GetPoint API to find stop IDs based on stop names or locations.
+	// Raw point finder results and names appended to the ID are accepted, see NormalizeStopId.
 	StopId string
 
 	// Format specifies the response format. Optional parameter.
 	// Supported values depend on the DVB API implementation.
 	Format *string
+
+	// Mot restricts the returned lines to these modes of transport, e.g. only trams.
+	// The API does not filter lines itself, so they are filtered client-side.
+	// Optional parameter, all lines are returned if empty.
+	Mot []MotType
 }
 
 // GetLinesResponse represents the response from the DVB lines API.
@@ -29,7 +37,15 @@ type GetLinesResponse struct {
 	Status Status `json:"Status"`
 
 	// ExpirationTime indicates when this response data expires and should be refreshed
-	ExpirationTime string `json:"ExpirationTime"`
+	ExpirationTime Time `json:"ExpirationTime"`
+
+	// client and params produced the response, see Refresh
+	client *Client
+	params *GetLinesParams
+
+	// Stale is true if the response was served from the cache after it had expired,
+	// while a fresh response is fetched in the background, see Config.StaleWhileRevalidate
+	Stale bool `json:"-"`
 }
 
 // Line represents a single public transport line that serves a stop.
@@ -38,8 +54,8 @@ type Line struct {
 	// Name is the display name of the line (e.g., "11", "85", "S1")
 	Name string `json:"Name"`
 
-	// Mot indicates the mode of transport (e.g., "Tram", "Bus", "S-Bahn")
-	Mot string `json:"Mot"`
+	// Mot indicates the mode of transport (e.g., MotTram, MotCityBus, MotSuburbanRailway)
+	Mot MotType `json:"Mot"`
 
 	// Changes contains information about any service changes or disruptions for this line
 	Changes []string `json:"Changes,omitempty"`
@@ -83,10 +99,16 @@ type TimeTable struct {
 // Parameters:
 //   - ctx: Context for the request, allowing for cancellation and timeouts
 //   - options: Parameters including the required stop ID and optional format specification
+//   - requestOpts: Optional per-request overrides like headers or a timeout, see RequestOption
 //
 // Returns:
 //   - *GetLinesResponse: Contains the list of lines and metadata
-//   - error: Returns an error if the stop ID is empty or if the API request fails
+//   - error: Returns an error if the stop ID is empty or if the API request fails.
+//     An error wrapping ErrInvalidStopId is returned without sending a request if
+//     the stop ID is malformed, see NormalizeStopId.
+//     A *NotFoundError wrapping ErrStopNotFound is returned if the stop is unknown.
+//     A *StatusError wrapping ErrValidation or ErrServiceUnavailable is returned
+//     if the API reports a failure in the response's Status.
 //
 // Example usage:
 //
@@ -104,19 +126,24 @@ type TimeTable struct {
 //			fmt.Printf("  → %s\n", direction.Name)
 //		}
 //	}
-func (c *Client) GetLines(ctx context.Context, options *GetLinesParams) (*GetLinesResponse, error) {
+func (c *Client) GetLines(ctx context.Context, options *GetLinesParams, requestOpts ...RequestOption) (*GetLinesResponse, error) {
+	ctx, cancel := withRequestOptions(ctx, requestOpts)
+	defer cancel()
+
 	query := url.Values{}
 
 	if options != nil {
-		if options.StopId != "" {
-			query.Set("stopid", options.StopId)
-		} else {
+		if options.StopId == "" {
 			return nil, errors.New("stopid can not be empty")
 		}
+		stopId, ok := NormalizeStopId(options.StopId)
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidStopId, options.StopId)
+		}
+		query.Set("stopid", stopId)
 		if options.Format != nil && *options.Format != "" {
 			query.Set("format", *options.Format)
 		}
-
 	}
 
 	opts := requestOptions{
@@ -125,15 +152,21 @@ func (c *Client) GetLines(ctx context.Context, options *GetLinesParams) (*GetLin
 		Query:  query,
 	}
 
-	resp, err := c.doRequest(ctx, opts)
-	if err != nil {
+	var resource GetLinesResponse
+	if err := c.doCachedRequest(ctx, opts, &resource); err != nil {
 		return nil, err
 	}
 
-	var resource GetLinesResponse
-	if err := c.handleResponse(resp, &resource); err != nil {
-		return nil, err
+	if resource.Status.Code != statusOk && len(resource.Lines) == 0 {
+		return nil, &NotFoundError{Err: ErrStopNotFound, Query: query.Get("stopid"), Status: resource.Status}
+	}
+
+	if options != nil && len(options.Mot) > 0 {
+		resource.Lines = slices.DeleteFunc(resource.Lines, func(line Line) bool {
+			return !slices.Contains(options.Mot, line.Mot)
+		})
 	}
 
+	resource.client, resource.params = c, options
 	return &resource, nil
 }
@@ -3,9 +3,11 @@ package dvb
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/url"
 	"strconv"
+	"time"
 )
 
 // MonitorStopParams contains the parameters for monitoring departures from a specific stop.
@@ -13,6 +15,7 @@ import (
 type MonitorStopParams struct {
 	// StopId is the unique identifier for the stop to monitor. This is required and cannot be empty.
 	// Use the GetPoint API to find stop IDs based on stop names or locations.
+	// Raw point finder results and names appended to the ID are accepted, see NormalizeStopId.
 	StopId string
 
 	// Format specifies the response format. Optional parameter.
@@ -20,9 +23,8 @@ type MonitorStopParams struct {
 	Format *string
 
 	// Time specifies the time for which to get departures. Optional parameter.
-	// Format should be compatible with the DVB API time format.
-	// If not specified, uses the current time.
-	Time *string
+	// It is converted to the API's format and time zone. If not specified, uses the current time.
+	Time *time.Time
 
 	// IsArrival when set to true, shows arrivals instead of departures.
 	// When false or nil, shows departures (default behavior).
@@ -54,10 +56,22 @@ type MonitorStopResponse struct {
 	Place string `json:"Place"`
 
 	// ExpirationTime indicates when this response data expires and should be refreshed
-	ExpirationTime string `json:"ExpirationTime"`
+	ExpirationTime Time `json:"ExpirationTime"`
+
+	// client and params produced the response, see Refresh
+	client *Client
+	params *MonitorStopParams
 
 	// Departures is an array of upcoming departures/arrivals from this stop
 	Departures []Departure `json:"Departures"`
+
+	// ScheduledOnly is true if the API could not be reached and the departures were
+	// computed from static schedule data instead. They carry no real-time information.
+	ScheduledOnly bool `json:"-"`
+
+	// Stale is true if the response was served from the cache after it had expired,
+	// while a fresh response is fetched in the background, see Config.StaleWhileRevalidate
+	Stale bool `json:"-"`
 }
 
 // Departure represents a single departure or arrival at a monitored stop.
@@ -78,14 +92,14 @@ type Departure struct {
 	// Platform contains information about the platform or stop position
 	Platform Platform `json:"Platform"`
 
-	// Mot indicates the mode of transport (e.g., "Tram", "Bus", "S-Bahn")
-	Mot string `json:"Mot"`
+	// Mot indicates the mode of transport (e.g., MotTram, MotCityBus, MotSuburbanRailway)
+	Mot MotType `json:"Mot"`
 
 	// RealTime is the actual departure/arrival time including delays
-	RealTime string `json:"RealTime"`
+	RealTime Time `json:"RealTime"`
 
 	// ScheduledTime is the originally planned departure/arrival time
-	ScheduledTime string `json:"ScheduledTime"`
+	ScheduledTime Time `json:"ScheduledTime"`
 
 	// State indicates the current status of the departure (e.g., "InTime", "Delayed", "Cancelled")
 	State string `json:"State"`
@@ -99,8 +113,8 @@ type Departure struct {
 	// CancelReasons contains reasons if the departure is cancelled
 	CancelReasons []string `json:"CancelReasons"`
 
-	// Occupancy indicates how crowded the vehicle is (e.g., "Low", "Medium", "High")
-	Occupancy string `json:"Occupancy"`
+	// Occupancy indicates how crowded the vehicle is, OccupancyUnknown if not reported
+	Occupancy Occupancy `json:"Occupancy"`
 }
 
 // MonitorStop retrieves real-time departure and arrival information for a specific stop.
@@ -114,17 +128,26 @@ type Departure struct {
 // Parameters:
 //   - ctx: Context for the request, allowing for cancellation and timeouts
 //   - options: Monitoring parameters including the required stop ID and optional filters
+//   - requestOpts: Optional per-request overrides like headers or a timeout, see RequestOption
 //
 // Returns:
 //   - *MonitorStopResponse: Contains the departure/arrival information and metadata
-//   - error: Returns an error if the stop ID is empty or if the API request fails
+//   - error: Returns an error if the stop ID is empty or if the API request fails.
+//     An error wrapping ErrInvalidStopId is returned without sending a request if
+//     the stop ID is malformed, see NormalizeStopId.
+//     A *NotFoundError wrapping ErrStopNotFound is returned if the stop is unknown.
+//     A stop without departures in the requested window is not an error: the
+//     response is returned with empty Departures, unless the API itself reports
+//     it in the Status, which results in a *NotFoundError wrapping ErrNoDepartures.
+//     A *StatusError wrapping ErrValidation or ErrServiceUnavailable is returned
+//     if the API reports a failure in the response's Status.
 //
 // Example usage:
 //
 //	params := &MonitorStopParams{
 //		StopId: "33000037",
-//		Limit: &[]int{10}[0],
-//		ShortTermChanges: &[]bool{true}[0],
+//		Limit: Int(10),
+//		ShortTermChanges: Bool(true),
 //	}
 //	response, err := client.MonitorStop(ctx, params)
 //	if err != nil {
@@ -134,20 +157,26 @@ type Departure struct {
 //	for _, dep := range response.Departures {
 //		fmt.Printf("Line %s to %s: %s\n", dep.LineName, dep.Direction, dep.RealTime)
 //	}
-func (c *Client) MonitorStop(ctx context.Context, options *MonitorStopParams) (*MonitorStopResponse, error) {
+func (c *Client) MonitorStop(ctx context.Context, options *MonitorStopParams, requestOpts ...RequestOption) (*MonitorStopResponse, error) {
+	ctx, cancel := withRequestOptions(ctx, requestOpts)
+	defer cancel()
+
 	query := url.Values{}
 
 	if options != nil {
-		if options.StopId != "" {
-			query.Set("stopid", options.StopId)
-		} else {
+		if options.StopId == "" {
 			return nil, errors.New("stopid can not be empty")
 		}
+		stopId, ok := NormalizeStopId(options.StopId)
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidStopId, options.StopId)
+		}
+		query.Set("stopid", stopId)
 		if options.Format != nil && *options.Format != "" {
 			query.Set("format", *options.Format)
 		}
-		if options.Time != nil && *options.Time != "" {
-			query.Set("time", *options.Time)
+		if options.Time != nil && !options.Time.IsZero() {
+			query.Set("time", formatQueryTime(*options.Time))
 		}
 		if options.IsArrival != nil {
 			query.Set("isarrival", strconv.FormatBool(*options.IsArrival))
@@ -169,15 +198,48 @@ func (c *Client) MonitorStop(ctx context.Context, options *MonitorStopParams) (*
 		Query:  query,
 	}
 
-	resp, err := c.doRequest(ctx, opts)
-	if err != nil {
-		return nil, err
+	var resource MonitorStopResponse
+	if err := c.doCachedRequest(ctx, opts, &resource); err != nil {
+		return c.scheduledDepartures(ctx, options, query, err)
 	}
 
-	var resource MonitorStopResponse
-	if err := c.handleResponse(resp, &resource); err != nil {
-		return nil, err
+	stopId := query.Get("stopid")
+	if resource.Status.Code != statusOk && resource.Name == "" {
+		return nil, &NotFoundError{Err: ErrStopNotFound, Query: stopId, Status: resource.Status}
 	}
 
+	resource.client, resource.params = c, options
 	return &resource, nil
 }
+
+// scheduledDepartures answers a failed MonitorStop request from the configured
+// ScheduleSource, if any. Only network errors and server-side failures, including
+// ErrServiceUnavailable, fall back; all other errors are returned unchanged.
+func (c *Client) scheduledDepartures(ctx context.Context, options *MonitorStopParams, query url.Values, err error) (*MonitorStopResponse, error) {
+	if c.scheduleSource == nil || ctx.Err() != nil {
+		return nil, err
+	}
+	var apiErr *apiError
+	if errors.As(err, &apiErr) && apiErr.StatusCode < http.StatusInternalServerError {
+		return nil, err
+	}
+	var notFound *NotFoundError
+	var statusErr *StatusError
+	if errors.As(err, &notFound) || (errors.As(err, &statusErr) && !errors.Is(err, ErrServiceUnavailable)) {
+		return nil, err
+	}
+
+	from := time.Now()
+	if t, parseErr := time.Parse(time.RFC3339, query.Get("time")); parseErr == nil {
+		from = t
+	}
+	limit, _ := strconv.Atoi(query.Get("limit"))
+
+	resource, fallbackErr := c.scheduleSource.ScheduledDepartures(query.Get("stopid"), from, limit)
+	if fallbackErr != nil || resource == nil {
+		return nil, err
+	}
+	resource.ScheduledOnly = true
+	resource.client, resource.params = c, options
+	return resource, nil
+}
@@ -0,0 +1,121 @@
+package dvb
+
+import (
+	"context"
+	"errors"
+	"sort"
+)
+
+// MonitorStopsParams contains the parameters for monitoring multiple stops at once.
+type MonitorStopsParams struct {
+	// Stop contains the MonitorStop parameters applied to every stop. StopId is ignored.
+	Stop MonitorStopParams
+
+	// Workers is the number of stops queried concurrently (optional, defaults to 4)
+	Workers int
+}
+
+// MonitorStopsResponse contains the departure boards of multiple stops.
+type MonitorStopsResponse struct {
+	// Stops contains the response of every stop that was queried successfully, keyed by stop ID
+	Stops map[string]*MonitorStopResponse
+
+	// Errors contains the error of every stop that failed, keyed by stop ID
+	Errors map[string]error
+}
+
+// StopDeparture is a departure together with the stop it departs from
+type StopDeparture struct {
+	StopId string
+	Departure
+}
+
+// Departures merges the departures of all stops into a single board ordered by
+// expected departure time, e.g. for a station group spanning several stops.
+func (r *MonitorStopsResponse) Departures() []StopDeparture {
+	var departures []StopDeparture
+	for stopId, response := range r.Stops {
+		for _, departure := range response.Departures {
+			departures = append(departures, StopDeparture{StopId: stopId, Departure: departure})
+		}
+	}
+
+	sort.SliceStable(departures, func(i, j int) bool {
+		a, b := departures[i].ExpectedTime(), departures[j].ExpectedTime()
+		if a.Equal(b) {
+			return departures[i].StopId < departures[j].StopId
+		}
+		return a.Before(b)
+	})
+	return departures
+}
+
+// MonitorStops retrieves the departure boards of multiple stops concurrently with a
+// bounded number of workers. This function is used for departure boards of station
+// groups, where the departures of several stops are shown together.
+//
+// Parameters:
+//   - ctx: Context for the request, allowing for cancellation and timeouts
+//   - stopIds: The stop IDs to query. This is required and cannot be empty.
+//   - options: Optional parameters applied to every stop, may be nil
+//   - requestOpts: Optional per-request overrides like headers or a timeout, see RequestOption
+//
+// Returns:
+//   - *MonitorStopsResponse: Contains the responses of all successful stops and the
+//     errors of all failed stops. Partial results are returned if some stops fail.
+//   - error: Returns an error if stopIds is empty or if ctx is cancelled. Failures of
+//     individual stops are reported in MonitorStopsResponse.Errors instead; a stop
+//     without departures is a successful response with empty Departures.
+//
+// Example usage:
+//
+//	response, err := client.MonitorStops(ctx, []string{"33000028", "33000029"}, &MonitorStopsParams{
+//		Stop: MonitorStopParams{Limit: Int(10)},
+//	})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for stopId, err := range response.Errors {
+//		log.Printf("%s: %v", stopId, err)
+//	}
+//	for _, departure := range response.Departures() {
+//		fmt.Println(departure.StopId, departure.LineName, departure.Direction)
+//	}
+func (c *Client) MonitorStops(ctx context.Context, stopIds []string, options *MonitorStopsParams, requestOpts ...RequestOption) (*MonitorStopsResponse, error) {
+	ctx, cancel := withRequestOptions(ctx, requestOpts)
+	defer cancel()
+
+	if len(stopIds) == 0 {
+		return nil, errors.New("stopids can not be empty")
+	}
+
+	var params MonitorStopsParams
+	if options != nil {
+		params = *options
+	}
+
+	response := &MonitorStopsResponse{
+		Stops:  make(map[string]*MonitorStopResponse),
+		Errors: make(map[string]error),
+	}
+	err := RunBulk(ctx, stopIds,
+		func(ctx context.Context, stopId string) (*MonitorStopResponse, error) {
+			stop := params.Stop
+			stop.StopId = stopId
+			return c.MonitorStop(ctx, &stop)
+		},
+		func(stopId string, stop *MonitorStopResponse, err error) error {
+			if err != nil {
+				response.Errors[stopId] = err
+			} else {
+				response.Stops[stopId] = stop
+			}
+			return nil
+		},
+		BulkOptions{Workers: params.Workers},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
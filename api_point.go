@@ -45,11 +45,20 @@ type GetPointResponse struct {
 	// Status contains the API response status including error codes and messages
 	Status Status `json:"Status"`
 
-	// Points is an array of point identifiers that match the search query
-	Points []string `json:"Points"`
+	// Points are the parsed points that match the search query. The raw
+	// pipe-delimited strings returned by the API remain available as Point.Raw.
+	Points Points `json:"Points"`
 
 	// ExpirationTime indicates when this response data expires and should be refreshed
-	ExpirationTime string `json:"ExpirationTime"`
+	ExpirationTime Time `json:"ExpirationTime"`
+
+	// client and params produced the response, see Refresh
+	client *Client
+	params *GetPointParams
+
+	// Stale is true if the response was served from the cache after it had expired,
+	// while a fresh response is fetched in the background, see Config.StaleWhileRevalidate
+	Stale bool `json:"-"`
 }
 
 // GetPoint searches for public transport stops, stations, and points of interest
@@ -63,26 +72,32 @@ type GetPointResponse struct {
 // Parameters:
 //   - ctx: Context for the request, allowing for cancellation and timeouts
 //   - options: Search parameters including the required query string and optional filters
+//   - requestOpts: Optional per-request overrides like headers or a timeout, see RequestOption
 //
 // Returns:
 //   - *GetPointResponse: Contains the search results and metadata
-//   - error: Returns an error if the query is empty or if the API request fails
+//   - error: Returns an error if the query is empty or if the API request fails.
+//     A *StatusError wrapping ErrValidation or ErrServiceUnavailable is returned
+//     if the API reports a failure in the response's Status.
 //
 // Example usage:
 //
 //	params := &GetPointParams{
 //		Query: "Hauptbahnhof",
-//		StopsOnly: &[]bool{true}[0],
-//		Limit: &[]int{5}[0],
+//		StopsOnly: Bool(true),
+//		Limit: Int(5),
 //	}
 //	response, err := client.GetPoint(ctx, params)
 //	if err != nil {
 //		log.Fatal(err)
 //	}
-//	for _, point := range response.Points {
-//		fmt.Println("Found point:", point)
+//	for _, point := range response.Points.OnlyStops() {
+//		fmt.Println("Found stop:", point.Id, point.Name)
 //	}
-func (c *Client) GetPoint(ctx context.Context, options *GetPointParams) (*GetPointResponse, error) {
+func (c *Client) GetPoint(ctx context.Context, options *GetPointParams, requestOpts ...RequestOption) (*GetPointResponse, error) {
+	ctx, cancel := withRequestOptions(ctx, requestOpts)
+	defer cancel()
+
 	query := url.Values{}
 
 	if options != nil {
@@ -114,15 +129,11 @@ func (c *Client) GetPoint(ctx context.Context, options *GetPointParams) (*GetPoi
 		Query:  query,
 	}
 
-	resp, err := c.doRequest(ctx, opts)
-	if err != nil {
-		return nil, err
-	}
-
 	var resource GetPointResponse
-	if err := c.handleResponse(resp, &resource); err != nil {
+	if err := c.doCachedRequest(ctx, opts, &resource); err != nil {
 		return nil, err
 	}
 
+	resource.client, resource.params = c, options
 	return &resource, nil
 }
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"time"
 )
 
 // GetRouteParams contains the parameters for trip planning between two locations.
@@ -33,13 +34,43 @@ type GetRouteParams struct {
 	ShortTermChanges *bool
 
 	// Time specifies the departure or arrival time for the journey. Optional parameter.
-	// Format should be compatible with the DVB API time format.
-	// If not specified, uses the current time.
-	Time *string
+	// It is converted to the API's format and time zone. If not specified, uses the current time.
+	Time *time.Time
 
 	// Via specifies an intermediate stop that the route should pass through.
 	// Optional parameter for more specific route planning.
 	Via *string
+
+	// ViaDwellTime specifies how long to stay at the Via stop, in minutes (e.g. 30 for an errand).
+	// Optional parameter, only valid in combination with Via.
+	ViaDwellTime *int
+
+	// Vias specifies an ordered list of intermediate stops, each with an optional dwell time,
+	// for multi-errand trips. Optional parameter, can not be combined with Via.
+	// A single stop is sent as Via and ViaDwellTime. As the API supports only one via
+	// stop, longer lists are planned as consecutive segments and returned as a single
+	// synthesized route.
+	Vias []Via
+
+	// WalkingFallback when set to true, appends a synthesized walking-only route
+	// if the API does not return any connection (e.g. origin and destination are very close).
+	// The walking route is estimated from the straight-line distance between both points.
+	WalkingFallback *bool
+
+	// SessionId continues a previous planning session (see GetRouteResponse.SessionId),
+	// so the planner can reuse its state. Optional parameter.
+	SessionId *string
+
+	// Settings contains advanced routing preferences like the maximum number of changes,
+	// walking speed or allowed modes of transport. Optional parameter.
+	// If set, the request is sent as POST with the parameters in a JSON body.
+	Settings *RouteSettings
+
+	// Accessibility contains mobility restrictions for barrier-free journeys, like
+	// avoiding stairs or requiring low-floor vehicles. Optional parameter.
+	// If set, the request is sent as POST, see Settings. Fields set in
+	// Settings.Mobility take precedence.
+	Accessibility *AccessibilityParams
 }
 
 // GetRouteResponse represents the response from the DVB trip planning API.
@@ -53,6 +84,10 @@ type GetRouteResponse struct {
 
 	// Routes is an array of possible journey options from origin to destination
 	Routes []Route `json:"Routes"`
+
+	// client and params produced the response, see Refresh
+	client *Client
+	params *GetRouteParams
 }
 
 // Route represents a single journey option from origin to destination.
@@ -108,6 +143,10 @@ type Route struct {
 
 	// Tickets lists all available ticket types for this journey
 	Tickets []Ticket `json:"Tickets"`
+
+	// Synthesized is true if this route was not returned by the API but
+	// generated client-side, e.g. by the walking fallback
+	Synthesized bool `json:"-"`
 }
 
 // MotChain represents a mode of transport used in the journey.
@@ -119,8 +158,8 @@ type MotChain struct {
 	// StatelessId is an alternative identifier for the transport line
 	StatelessId string `json:"StatelessId"`
 
-	// Type indicates the mode of transport (e.g., "Tram", "Bus", "S-Bahn", "Walking")
-	Type string `json:"Type"`
+	// Type indicates the mode of transport (e.g., MotTram, MotCityBus, MotFootpath)
+	Type MotType `json:"Type"`
 
 	// Name is the line name or number (e.g., "11", "85", "S1")
 	Name string `json:"Name"`
@@ -172,10 +211,10 @@ type PartialRoute struct {
 	ChangeoverEndangered *bool `json:"ChangeoverEndangered,omitempty"`
 
 	// NextDepartureTimes lists alternative departure times for this segment
-	NextDepartureTimes []string `json:"NextDepartureTimes,omitempty"`
+	NextDepartureTimes []Time `json:"NextDepartureTimes,omitempty"`
 
 	// PreviousDepartureTimes lists earlier departure options for this segment
-	PreviousDepartureTimes []string `json:"PreviousDepartureTimes,omitempty"`
+	PreviousDepartureTimes []Time `json:"PreviousDepartureTimes,omitempty"`
 }
 
 // Mot represents detailed mode of transport information for a route segment.
@@ -187,8 +226,8 @@ type Mot struct {
 	// StatelessId is an alternative identifier for the transport line
 	StatelessId *string `json:"StatelessId,omitempty"`
 
-	// Type indicates the mode of transport (e.g., "Tram", "Bus", "S-Bahn", "Walking")
-	Type string `json:"Type"`
+	// Type indicates the mode of transport (e.g., MotTram, MotCityBus, MotFootpath)
+	Type MotType `json:"Type"`
 
 	// Name is the line name or number (e.g., "11", "85", "S1")
 	Name *string `json:"Name,omitempty"`
@@ -213,22 +252,25 @@ type Mot struct {
 
 	// TrainNumber is the specific train number for rail services
 	TrainNumber *string `json:"TrainNumber,omitempty"`
+
+	// LowFloor is true if the vehicle has step-free entrances, nil if unknown
+	LowFloor *bool `json:"LowFloor,omitempty"`
 }
 
 // RegularStop represents a stop visited during a journey segment.
 // This provides detailed timing and location information for each stop.
 type RegularStop struct {
 	// ArrivalTime is the scheduled arrival time at this stop
-	ArrivalTime string `json:"ArrivalTime"`
+	ArrivalTime Time `json:"ArrivalTime"`
 
 	// DepartureTime is the scheduled departure time from this stop
-	DepartureTime string `json:"DepartureTime"`
+	DepartureTime Time `json:"DepartureTime"`
 
 	// ArrivalRealTime is the real-time arrival time including delays
-	ArrivalRealTime *string `json:"ArrivalRealTime,omitempty"`
+	ArrivalRealTime *Time `json:"ArrivalRealTime,omitempty"`
 
 	// DepartureRealTime is the real-time departure time including delays
-	DepartureRealTime *string `json:"DepartureRealTime,omitempty"`
+	DepartureRealTime *Time `json:"DepartureRealTime,omitempty"`
 
 	// Place indicates the city or area where this stop is located
 	Place string `json:"Place"`
@@ -266,8 +308,11 @@ type RegularStop struct {
 	// ParkAndRail contains information about park and ride facilities
 	ParkAndRail []string `json:"ParkAndRail"`
 
-	// Occupancy indicates how crowded the vehicle is at this stop
-	Occupancy string `json:"Occupancy"`
+	// Occupancy indicates how crowded the vehicle is at this stop, OccupancyUnknown if not reported
+	Occupancy Occupancy `json:"Occupancy"`
+
+	// Accessibility describes the accessibility of the stop, nil if unknown
+	Accessibility *StopAccessibility `json:"Accessibility,omitempty"`
 }
 
 // Ticket represents a ticket option available for the journey.
@@ -300,17 +345,21 @@ type Ticket struct {
 // Parameters:
 //   - ctx: Context for the request, allowing for cancellation and timeouts
 //   - options: Trip planning parameters including required origin and destination, plus optional timing and routing preferences
+//   - requestOpts: Optional per-request overrides like headers or a timeout, see RequestOption
 //
 // Returns:
 //   - *GetRouteResponse: Contains multiple route options with detailed journey information
-//   - error: Returns an error if origin or destination is empty, or if the API request fails
+//   - error: Returns an error if origin or destination is empty, or if the API request fails.
+//     A *NotFoundError wrapping ErrNoRoute is returned if no connection was found.
+//     A *StatusError wrapping ErrValidation or ErrServiceUnavailable is returned
+//     if the API reports a failure in the response's Status.
 //
 // Example usage:
 //
 //	params := &GetRouteParams{
 //		Origin: "Hauptbahnhof",
 //		Destination: "Neustadt Bahnhof",
-//		ShortTermChanges: &[]bool{true}[0],
+//		ShortTermChanges: Bool(true),
 //	}
 //	response, err := client.GetRoute(ctx, params)
 //	if err != nil {
@@ -321,7 +370,49 @@ type Ticket struct {
 //		fmt.Printf("Route %d: %d minutes, %d transfers, Price: %s\n",
 //			i+1, route.Duration, route.Interchanges, route.Price)
 //	}
-func (c *Client) GetRoute(ctx context.Context, options *GetRouteParams) (*GetRouteResponse, error) {
+//
+// Advanced routing preferences are sent with Settings:
+//
+//	params.Settings = &RouteSettings{
+//		Standard: &StandardSettings{MaxChanges: String("One"), Mot: []MotType{MotTram, MotCityBus}},
+//		Mobility: &MobilitySettings{MobilityRestriction: String("High")},
+//	}
+//
+// Multi-errand trips are planned with Vias:
+//
+//	params.Vias = []Via{
+//		{StopId: "33000037", DwellTime: 15 * time.Minute},
+//		{StopId: "33000016", DwellTime: 30 * time.Minute},
+//	}
+//
+// Barrier-free journeys are planned with Accessibility:
+//
+//	params.Accessibility = &AccessibilityParams{NoStairs: true, LowFloorOnly: true}
+func (c *Client) GetRoute(ctx context.Context, options *GetRouteParams, requestOpts ...RequestOption) (*GetRouteResponse, error) {
+	ctx, cancel := withRequestOptions(ctx, requestOpts)
+	defer cancel()
+
+	if options != nil && len(options.Vias) > 1 {
+		return c.routeVias(ctx, options)
+	}
+	if options != nil && len(options.Vias) == 1 {
+		if options.Via != nil && *options.Via != "" {
+			return nil, errors.New("via and vias can not be combined")
+		}
+		via := options.Vias[0]
+		if via.StopId == "" {
+			return nil, errors.New("via stop id can not be empty")
+		}
+		params := *options
+		params.Via, params.Vias = &via.StopId, nil
+		params.ViaDwellTime = via.dwellMinutes()
+		return c.GetRoute(ctx, &params)
+	}
+
+	if options == nil {
+		return nil, errors.New("origin can not be empty")
+	}
+
 	query := url.Values{}
 
 	if options != nil {
@@ -344,12 +435,21 @@ func (c *Client) GetRoute(ctx context.Context, options *GetRouteParams) (*GetRou
 		if options.ShortTermChanges != nil {
 			query.Set("shorttermchanges", strconv.FormatBool(*options.ShortTermChanges))
 		}
-		if options.Time != nil && *options.Time != "" {
-			query.Set("time", *options.Time)
+		if options.Time != nil && !options.Time.IsZero() {
+			query.Set("time", formatQueryTime(*options.Time))
 		}
 		if options.Via != nil && *options.Via != "" {
 			query.Set("via", *options.Via)
 		}
+		if options.ViaDwellTime != nil && *options.ViaDwellTime > 0 {
+			if options.Via == nil || *options.Via == "" {
+				return nil, errors.New("via dwell time requires a via stop")
+			}
+			query.Set("dwelltime", strconv.Itoa(*options.ViaDwellTime))
+		}
+		if options.SessionId != nil && *options.SessionId != "" {
+			query.Set("sessionId", *options.SessionId)
+		}
 	}
 
 	opts := requestOptions{
@@ -357,16 +457,45 @@ func (c *Client) GetRoute(ctx context.Context, options *GetRouteParams) (*GetRou
 		Path:   "/tr/trips",
 		Query:  query,
 	}
+	if options != nil && (options.Settings != nil || options.Accessibility != nil) {
+		var settings RouteSettings
+		if options.Settings != nil {
+			settings = *options.Settings
+		}
+		settings.Mobility = options.Accessibility.mobilitySettings(settings.Mobility)
+
+		opts.Method, opts.Query = http.MethodPost, nil
+		opts.Body = newTripRequest(query, &settings)
+	}
 
 	resp, err := c.doRequest(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
 
+	walkingFallback := options != nil && options.WalkingFallback != nil && *options.WalkingFallback
+
 	var resource GetRouteResponse
-	if err := c.handleResponse(resp, &resource); err != nil {
+	if err := c.handleResponse(resp, &resource); err != nil && !(walkingFallback && errors.Is(err, ErrNoRoute)) {
 		return nil, err
 	}
 
+	if len(resource.Routes) == 0 && walkingFallback {
+		// The fallback is best-effort: if the endpoints can't be located,
+		// no route is added and ErrNoRoute is returned below.
+		if route, err := c.walkingRoute(ctx, options.Origin, options.Destination); err == nil {
+			resource.Routes = append(resource.Routes, *route)
+		}
+	}
+
+	if len(resource.Routes) == 0 {
+		return nil, &NotFoundError{
+			Err:    ErrNoRoute,
+			Query:  query.Get("origin") + " → " + query.Get("destination"),
+			Status: resource.Status,
+		}
+	}
+
+	resource.client, resource.params = c, options
 	return &resource, nil
 }
@@ -0,0 +1,183 @@
+package dvb
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// GetRouteChangesParams contains the parameters for retrieving the current route changes
+// (disruptions, construction work and diversions) of the network.
+type GetRouteChangesParams struct {
+	// ShortTerm when set to true, includes short-term changes like disruptions caused
+	// by accidents. When false or nil, only planned changes are returned.
+	ShortTerm *bool
+
+	// Format specifies the response format. Optional parameter.
+	// Supported values depend on the DVB API implementation.
+	Format *string
+}
+
+// GetRouteChangesResponse represents the response from the DVB route changes API.
+type GetRouteChangesResponse struct {
+	// Changes lists all current and upcoming route changes
+	Changes []RouteChange `json:"Changes"`
+
+	// Lines lists the lines affected by the changes
+	Lines []RouteChangeLine `json:"Lines"`
+
+	// Status contains the API response status including error codes and messages
+	Status Status `json:"Status"`
+
+	// ExpirationTime indicates when this response data expires and should be refreshed
+	ExpirationTime Time `json:"ExpirationTime"`
+
+	// client and params produced the response, see Refresh
+	client *Client
+	params *GetRouteChangesParams
+}
+
+// RouteChange is a single disruption, construction work or diversion.
+type RouteChange struct {
+	// Id is the unique identifier of the change, as referenced by Line.Changes,
+	// Departure.RouteChanges and MotChain.Changes
+	Id string `json:"Id"`
+
+	// Title is a short summary of the change
+	Title string `json:"Title"`
+
+	// Description is the full description of the change, formatted as HTML
+	Description string `json:"Description"`
+
+	// Type classifies the change (e.g., "Scheduled", "ShortTerm", "AmplifyingTransport")
+	Type string `json:"Type"`
+
+	// TripRequestInclude indicates whether the trip planner takes the change into account
+	TripRequestInclude bool `json:"TripRequestInclude"`
+
+	// PublishDate is when the change was published
+	PublishDate Time `json:"PublishDate"`
+
+	// LineIds lists the Id of every RouteChangeLine affected by the change
+	LineIds []string `json:"LineIds"`
+
+	// ValidityPeriods are the time ranges in which the change is in effect
+	ValidityPeriods []ValidityPeriod `json:"ValidityPeriods"`
+}
+
+// ValidityPeriod is a time range in which a route change is in effect.
+type ValidityPeriod struct {
+	// Begin is the start of the period
+	Begin Time `json:"Begin"`
+
+	// End is the end of the period, or the zero time if it is open-ended
+	End Time `json:"End"`
+}
+
+// RouteChangeLine is a line affected by route changes.
+type RouteChangeLine struct {
+	// Id is the identifier of the line within the route changes response
+	Id string `json:"Id"`
+
+	// Name is the display name of the line (e.g., "11", "85", "S1")
+	Name string `json:"Name"`
+
+	// TransportationCompany is the operator of the line
+	TransportationCompany string `json:"TransportationCompany"`
+
+	// Mot indicates the mode of transport (e.g., MotTram, MotCityBus)
+	Mot MotType `json:"Mot"`
+
+	// Divas contains the DIVA identifiers of the line
+	Divas []Diva `json:"Divas"`
+
+	// Changes lists the Id of every RouteChange affecting the line
+	Changes []string `json:"Changes"`
+}
+
+// ActiveAt reports whether the change is in effect at t. Changes without validity
+// periods are always considered active.
+func (c *RouteChange) ActiveAt(t time.Time) bool {
+	if len(c.ValidityPeriods) == 0 {
+		return true
+	}
+	for _, period := range c.ValidityPeriods {
+		if !t.Before(period.Begin.Time) && (period.End.IsZero() || t.Before(period.End.Time)) {
+			return true
+		}
+	}
+	return false
+}
+
+// LinesOf returns the lines affected by the change with the given Id
+func (r *GetRouteChangesResponse) LinesOf(changeId string) []RouteChangeLine {
+	var lines []RouteChangeLine
+	for _, line := range r.Lines {
+		if slices.Contains(line.Changes, changeId) {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// GetRouteChanges retrieves all current and upcoming route changes of the network,
+// such as disruptions, construction work and diversions, together with the lines
+// they affect. This function is used to show service alerts and to detect new
+// disruptions by polling.
+//
+// Parameters:
+//   - ctx: Context for the request, allowing for cancellation and timeouts
+//   - options: Optional parameters, may be nil
+//   - requestOpts: Optional per-request overrides like headers or a timeout, see RequestOption
+//
+// Returns:
+//   - *GetRouteChangesResponse: Contains the route changes, affected lines and metadata
+//   - error: Returns an error if the API request fails.
+//     A *StatusError wrapping ErrValidation or ErrServiceUnavailable is returned
+//     if the API reports a failure in the response's Status.
+//
+// Example usage:
+//
+//	response, err := client.GetRouteChanges(ctx, &GetRouteChangesParams{
+//		ShortTerm: Bool(true),
+//	})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, change := range response.Changes {
+//		if change.ActiveAt(time.Now()) {
+//			fmt.Printf("%s (%d lines)\n", change.Title, len(response.LinesOf(change.Id)))
+//		}
+//	}
+func (c *Client) GetRouteChanges(ctx context.Context, options *GetRouteChangesParams, requestOpts ...RequestOption) (*GetRouteChangesResponse, error) {
+	ctx, cancel := withRequestOptions(ctx, requestOpts)
+	defer cancel()
+
+	query := url.Values{}
+
+	if options != nil {
+		if options.ShortTerm != nil {
+			query.Set("shortterm", strconv.FormatBool(*options.ShortTerm))
+		}
+		if options.Format != nil && *options.Format != "" {
+			query.Set("format", *options.Format)
+		}
+	}
+
+	opts := requestOptions{
+		Method: http.MethodGet,
+		Path:   "/rc",
+		Query:  query,
+	}
+
+	var resource GetRouteChangesResponse
+	if err := c.doCachedRequest(ctx, opts, &resource); err != nil {
+		return nil, err
+	}
+
+	resource.client, resource.params = c, options
+	return &resource, nil
+}
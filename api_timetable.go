@@ -0,0 +1,208 @@
+package dvb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// DayType identifies the days a timetable applies to
+type DayType string
+
+const (
+	DayTypeWeekday  DayType = "Weekday"  // Monday to Friday
+	DayTypeSaturday DayType = "Saturday" // Saturday
+	DayTypeSunday   DayType = "Sunday"   // Sunday and public holidays
+)
+
+// DayTypeOf returns the day type of date. Public holidays are not detected and
+// return the day type of their weekday.
+func DayTypeOf(date time.Time) DayType {
+	switch date.Weekday() {
+	case time.Saturday:
+		return DayTypeSaturday
+	case time.Sunday:
+		return DayTypeSunday
+	default:
+		return DayTypeWeekday
+	}
+}
+
+// GetTimetableParams contains the parameters for retrieving the timetable of a line
+// direction at a stop.
+type GetTimetableParams struct {
+	// TimeTableId identifies the line, direction and timetable variant. This is
+	// required and cannot be empty. Use the Id of a TimeTable returned by GetLines.
+	TimeTableId string
+
+	// StopId is the stop to retrieve the departures at. This is required and cannot be empty.
+	StopId string
+
+	// Date selects the timetable period valid on this date.
+	// Optional parameter, defaults to the current date.
+	Date *time.Time
+}
+
+// GetTimetableResponse represents the response from the DVB timetable API.
+// It contains the scheduled departures of a line direction at a stop per day type.
+type GetTimetableResponse struct {
+	// Line is the display name of the line (e.g., "11", "85", "S1")
+	Line string `json:"Line"`
+
+	// Mot indicates the mode of transport of the line
+	Mot MotType `json:"Mot"`
+
+	// Direction is the destination of the line direction
+	Direction string `json:"Direction"`
+
+	// Days contains the departures per day type
+	Days []TimetableDay `json:"Days"`
+
+	// Status contains the API response status including error codes and messages
+	Status Status `json:"Status"`
+
+	// ExpirationTime indicates when this response data expires and should be refreshed
+	ExpirationTime Time `json:"ExpirationTime"`
+
+	// client and params produced the response, see Refresh
+	client *Client
+	params *GetTimetableParams
+}
+
+// TimetableDay contains the departures of one day type, e.g. Monday to Friday.
+type TimetableDay struct {
+	// Type identifies the days the departures apply to
+	Type DayType `json:"DayType"`
+
+	// Name is the display name of the day type (e.g., "Montag - Freitag")
+	Name string `json:"Name"`
+
+	// Rows contains the departures grouped by hour, ordered by hour
+	Rows []TimetableRow `json:"Rows"`
+}
+
+// TimetableRow contains the departures within one hour, like a row of a printed timetable.
+type TimetableRow struct {
+	// Hour is the hour of the departures. Hours after midnight of trips belonging to
+	// the previous operating day are continued beyond 23, e.g. 24 for 0:xx.
+	Hour int `json:"Hour"`
+
+	// Departures lists the departures within the hour, ordered by minute
+	Departures []TimetableDeparture `json:"Departures"`
+}
+
+// TimetableDeparture is a single scheduled departure of a timetable.
+type TimetableDeparture struct {
+	// Minute is the minute of the departure within its row's hour
+	Minute int `json:"Minute"`
+
+	// Note is a footnote of the departure, e.g. that it only runs to an intermediate stop
+	Note string `json:"Note,omitempty"`
+}
+
+// Day returns the departures of the day type, if the timetable has any
+func (r *GetTimetableResponse) Day(dayType DayType) (*TimetableDay, bool) {
+	for i := range r.Days {
+		if r.Days[i].Type == dayType {
+			return &r.Days[i], true
+		}
+	}
+	return nil, false
+}
+
+// Times returns the departure times on the given operating day, which starts at
+// midnight of date in Berlin time, e.g. to show a day's departures offline.
+func (d *TimetableDay) Times(date time.Time) []time.Time {
+	date = date.In(berlin)
+
+	var times []time.Time
+	for _, row := range d.Rows {
+		for _, departure := range row.Departures {
+			// time.Date normalizes hours beyond 23 into the next day
+			times = append(times, time.Date(date.Year(), date.Month(), date.Day(), row.Hour, departure.Minute, 0, 0, date.Location()))
+		}
+	}
+	return times
+}
+
+// GetTimetable retrieves the scheduled departures of a line direction at a stop,
+// grouped by day type and hour like a printed timetable. This function is used to
+// display schedules offline or for days beyond the real-time horizon of MonitorStop.
+//
+// Parameters:
+//   - ctx: Context for the request, allowing for cancellation and timeouts
+//   - options: Parameters including the required timetable ID and stop ID
+//   - requestOpts: Optional per-request overrides like headers or a timeout, see RequestOption
+//
+// Returns:
+//   - *GetTimetableResponse: Contains the departures per day type and metadata
+//   - error: Returns an error if a required parameter is empty or if the API request fails.
+//     A *StatusError wrapping ErrValidation or ErrServiceUnavailable is returned
+//     if the API reports a failure in the response's Status.
+//
+// Example usage:
+//
+//	lines, err := client.GetLines(ctx, &GetLinesParams{StopId: "33000028"})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	timetable, err := client.GetTimetable(ctx, &GetTimetableParams{
+//		TimeTableId: lines.Lines[0].Directions[0].TimeTables[0].Id,
+//		StopId:      "33000028",
+//	})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	if day, ok := timetable.Day(DayTypeOf(time.Now())); ok {
+//		for _, row := range day.Rows {
+//			fmt.Printf("%02d:", row.Hour)
+//			for _, departure := range row.Departures {
+//				fmt.Printf(" %02d", departure.Minute)
+//			}
+//			fmt.Println()
+//		}
+//	}
+func (c *Client) GetTimetable(ctx context.Context, options *GetTimetableParams, requestOpts ...RequestOption) (*GetTimetableResponse, error) {
+	ctx, cancel := withRequestOptions(ctx, requestOpts)
+	defer cancel()
+
+	query := url.Values{}
+
+	if options != nil {
+		if options.TimeTableId != "" {
+			query.Set("timetableid", options.TimeTableId)
+		} else {
+			return nil, errors.New("timetableid can not be empty")
+		}
+		if options.StopId == "" {
+			return nil, errors.New("stopid can not be empty")
+		}
+		stopId, ok := NormalizeStopId(options.StopId)
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidStopId, options.StopId)
+		}
+		query.Set("stopid", stopId)
+		if options.Date != nil && !options.Date.IsZero() {
+			query.Set("date", formatQueryTime(*options.Date))
+		}
+	} else {
+		return nil, errors.New("timetableid can not be empty")
+	}
+
+	opts := requestOptions{
+		Method: http.MethodGet,
+		Path:   "/stt/timetable",
+		Query:  query,
+	}
+
+	var resource GetTimetableResponse
+	if err := c.doCachedRequest(ctx, opts, &resource); err != nil {
+		return nil, err
+	}
+
+	resource.client, resource.params = c, options
+	return &resource, nil
+}
@@ -0,0 +1,177 @@
+package dvb
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// GetTripDetailsParams contains the parameters for retrieving the stops of a single trip.
+// This is typically used after picking a departure from MonitorStop to show where the vehicle is.
+type GetTripDetailsParams struct {
+	// TripId is the identifier of the trip. This is required and cannot be empty.
+	// Use the Id of a Departure returned by MonitorStop.
+	TripId string
+
+	// StopId is the stop the trip was picked at. This is required and cannot be empty.
+	StopId string
+
+	// Time is the departure time of the trip at StopId. This is required and cannot be zero.
+	// Use the ScheduledTime of the Departure returned by MonitorStop.
+	// It is converted to the API's format and time zone.
+	Time time.Time
+
+	// MapData when set to true, includes coordinate information for mapping the trip.
+	// When false or nil, no map data is returned.
+	MapData *bool
+}
+
+// GetTripDetailsResponse represents the response from the DVB trip details API.
+// It contains all stops of the trip with their scheduled and real-time times.
+type GetTripDetailsResponse struct {
+	// Stops lists all stops of the trip in travel order
+	Stops []TripStop `json:"Stops"`
+
+	// Status contains the API response status including error codes and messages
+	Status Status `json:"Status"`
+
+	// ExpirationTime indicates when this response data expires and should be refreshed
+	ExpirationTime Time `json:"ExpirationTime"`
+
+	// client and params produced the response, see Refresh
+	client *Client
+	params *GetTripDetailsParams
+}
+
+// TripStop represents a single stop of a trip.
+type TripStop struct {
+	// Id is the unique identifier of the stop
+	Id string `json:"Id"`
+
+	// Place indicates the city or area where the stop is located
+	Place string `json:"Place"`
+
+	// Name is the official name of the stop
+	Name string `json:"Name"`
+
+	// Position indicates where the stop is relative to the stop the trip was
+	// picked at ("Previous", "Current" or "Next")
+	Position string `json:"Position"`
+
+	// Platform contains information about the platform or stop position
+	Platform Platform `json:"Platform"`
+
+	// Latitude is the Gauss-Krüger zone 4 northing of the stop
+	Latitude int `json:"Latitude"`
+
+	// Longitude is the Gauss-Krüger zone 4 easting of the stop
+	Longitude int `json:"Longitude"`
+
+	// Time is the scheduled arrival/departure time at this stop
+	Time Time `json:"Time"`
+
+	// RealTime is the actual arrival/departure time including delays, if available
+	RealTime *Time `json:"RealTime,omitempty"`
+
+	// State indicates the real-time status at this stop (e.g., "InTime", "Delayed")
+	State *string `json:"State,omitempty"`
+
+	// Occupancy indicates how crowded the vehicle is at this stop, OccupancyUnknown if not reported
+	Occupancy Occupancy `json:"Occupancy"`
+}
+
+// ScheduledTime returns the parsed scheduled time at the stop, or the zero time if it is unknown
+func (s *TripStop) ScheduledTime() time.Time {
+	return s.Time.Time
+}
+
+// ExpectedTime returns the real-time value at the stop, falling back to the
+// scheduled time. Returns the zero time if both are unknown.
+func (s *TripStop) ExpectedTime() time.Time {
+	return firstDate(s.RealTime, &s.Time)
+}
+
+// GetTripDetails retrieves all stops of a single trip with their scheduled and
+// real-time times and platforms. This function is used to show a "where is my tram"
+// view after picking a departure from MonitorStop.
+//
+// Parameters:
+//   - ctx: Context for the request, allowing for cancellation and timeouts
+//   - options: Parameters including the required trip ID, stop ID and time
+//   - requestOpts: Optional per-request overrides like headers or a timeout, see RequestOption
+//
+// Returns:
+//   - *GetTripDetailsResponse: Contains the stops of the trip and metadata
+//   - error: Returns an error if a required parameter is empty or if the API request fails.
+//     A *NotFoundError wrapping ErrTripNotFound is returned if the trip is unknown.
+//     A *StatusError wrapping ErrValidation or ErrServiceUnavailable is returned
+//     if the API reports a failure in the response's Status.
+//
+// Example usage:
+//
+//	departure := monitor.Departures[0]
+//	params := &GetTripDetailsParams{
+//		TripId: departure.Id,
+//		StopId: "33000037",
+//		Time:   departure.ScheduledTime.Time,
+//	}
+//	response, err := client.GetTripDetails(ctx, params)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, stop := range response.Stops {
+//		fmt.Printf("%s %s (%s)\n", stop.ExpectedTime().Format("15:04"), stop.Name, stop.Position)
+//	}
+func (c *Client) GetTripDetails(ctx context.Context, options *GetTripDetailsParams, requestOpts ...RequestOption) (*GetTripDetailsResponse, error) {
+	ctx, cancel := withRequestOptions(ctx, requestOpts)
+	defer cancel()
+
+	query := url.Values{}
+
+	if options != nil {
+		if options.TripId != "" {
+			query.Set("tripid", options.TripId)
+		} else {
+			return nil, errors.New("tripid can not be empty")
+		}
+		if options.StopId != "" {
+			query.Set("stopid", options.StopId)
+		} else {
+			return nil, errors.New("stopid can not be empty")
+		}
+		if !options.Time.IsZero() {
+			query.Set("time", FormatDate(options.Time))
+		} else {
+			return nil, errors.New("time can not be empty")
+		}
+		if options.MapData != nil {
+			query.Set("mapdata", strconv.FormatBool(*options.MapData))
+		}
+	}
+
+	opts := requestOptions{
+		Method: http.MethodGet,
+		Path:   "/dm/trip",
+		Query:  query,
+	}
+
+	resp, err := c.doRequest(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var resource GetTripDetailsResponse
+	if err := c.handleResponse(resp, &resource); err != nil {
+		return nil, err
+	}
+
+	if len(resource.Stops) == 0 {
+		return nil, &NotFoundError{Err: ErrTripNotFound, Query: query.Get("tripid"), Status: resource.Status}
+	}
+
+	resource.client, resource.params = c, options
+	return &resource, nil
+}
@@ -0,0 +1,222 @@
+package dvb
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+	"sync"
+)
+
+// BulkOptions controls how a batch of requests is run by RunBulk.
+type BulkOptions struct {
+	// Workers is the number of requests run concurrently (optional, defaults to 4)
+	Workers int
+
+	// RateLimit caps the number of requests per second across all workers
+	// (optional, unlimited if 0). It applies in addition to the client's own limit.
+	RateLimit float64
+
+	// Progress is called after every finished key (optional). Calls are serialized.
+	Progress func(BulkProgress)
+
+	// Checkpoint records finished keys so an interrupted batch can be resumed
+	// without repeating them (optional), see FileCheckpoint
+	Checkpoint Checkpoint
+}
+
+// BulkProgress reports the state of a running batch
+type BulkProgress struct {
+	Total     int // Number of keys in the batch
+	Succeeded int // Keys fetched and handled successfully
+	Failed    int // Keys whose fetch returned an error
+	Skipped   int // Keys skipped because the checkpoint marked them as done
+}
+
+// Finished returns the number of keys that have been processed
+func (p BulkProgress) Finished() int {
+	return p.Succeeded + p.Failed + p.Skipped
+}
+
+// Checkpoint records which keys of a batch have been processed successfully.
+type Checkpoint interface {
+	// Done reports whether the key has been processed in a previous run
+	Done(key string) bool
+
+	// MarkDone records that the key has been processed
+	MarkDone(key string) error
+}
+
+// RunBulk calls fetch for every key with a bounded number of workers and passes the
+// results to handle, e.g. to enumerate the lines of all stops of the network.
+// Calls to handle are serialized. Keys for which fetch fails are reported to handle
+// with the error and are not checkpointed, so they are retried when the batch is
+// resumed. If handle returns an error, the batch is aborted and the error returned.
+//
+// Example usage:
+//
+//	checkpoint, err := dvb.OpenFileCheckpoint("lines.checkpoint")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	defer checkpoint.Close()
+//	err = dvb.RunBulk(ctx, stopIds,
+//		func(ctx context.Context, stopId string) (*dvb.GetLinesResponse, error) {
+//			return client.GetLines(ctx, &dvb.GetLinesParams{StopId: stopId})
+//		},
+//		func(stopId string, response *dvb.GetLinesResponse, err error) error {
+//			if err != nil {
+//				log.Printf("%s: %v", stopId, err)
+//				return nil
+//			}
+//			return store(stopId, response)
+//		},
+//		dvb.BulkOptions{Workers: 8, RateLimit: 5, Checkpoint: checkpoint},
+//	)
+func RunBulk[T any](
+	ctx context.Context,
+	keys []string,
+	fetch func(ctx context.Context, key string) (T, error),
+	handle func(key string, value T, err error) error,
+	options BulkOptions,
+) error {
+	if options.Workers <= 0 {
+		options.Workers = 4
+	}
+	limiter := newRateLimiter(options.RateLimit, 1)
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	var (
+		mu       sync.Mutex
+		progress = BulkProgress{Total: len(keys)}
+	)
+	report := func() {
+		if options.Progress != nil {
+			options.Progress(progress)
+		}
+	}
+
+	work := make(chan string)
+	var wg sync.WaitGroup
+	for range options.Workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for key := range work {
+				if err := limiter.wait(ctx); err != nil {
+					return
+				}
+				value, err := fetch(ctx, key)
+				if ctx.Err() != nil {
+					return
+				}
+
+				mu.Lock()
+				if handleErr := handle(key, value, err); handleErr != nil {
+					cancel(handleErr)
+				} else if err != nil {
+					progress.Failed++
+				} else if options.Checkpoint != nil {
+					if markErr := options.Checkpoint.MarkDone(key); markErr != nil {
+						cancel(fmt.Errorf("failed to update checkpoint: %w", markErr))
+					}
+				}
+				if err == nil && ctx.Err() == nil {
+					progress.Succeeded++
+				}
+				report()
+				mu.Unlock()
+			}
+		}()
+	}
+
+feed:
+	for _, key := range keys {
+		if options.Checkpoint != nil && options.Checkpoint.Done(key) {
+			mu.Lock()
+			progress.Skipped++
+			report()
+			mu.Unlock()
+			continue
+		}
+
+		select {
+		case work <- key:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(work)
+	wg.Wait()
+
+	return context.Cause(ctx)
+}
+
+// FileCheckpoint is a Checkpoint persisted as a file with one key per line.
+type FileCheckpoint struct {
+	mu   sync.Mutex
+	file *os.File
+	done map[string]bool
+}
+
+// OpenFileCheckpoint opens or creates the checkpoint file at path and loads the
+// keys recorded by previous runs.
+func OpenFileCheckpoint(path string) (*FileCheckpoint, error) {
+	done := make(map[string]bool)
+
+	existing, err := os.Open(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("failed to open checkpoint: %w", err)
+	}
+	if err == nil {
+		scanner := bufio.NewScanner(existing)
+		for scanner.Scan() {
+			if key := strings.TrimSpace(scanner.Text()); key != "" {
+				done[key] = true
+			}
+		}
+		existing.Close()
+		if err := scanner.Err(); err != nil {
+			return nil, fmt.Errorf("failed to read checkpoint: %w", err)
+		}
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open checkpoint: %w", err)
+	}
+
+	return &FileCheckpoint{file: file, done: done}, nil
+}
+
+// Done reports whether the key has been recorded
+func (c *FileCheckpoint) Done(key string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.done[key]
+}
+
+// MarkDone records the key and appends it to the file
+func (c *FileCheckpoint) MarkDone(key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.done[key] {
+		return nil
+	}
+	if _, err := c.file.WriteString(key + "\n"); err != nil {
+		return err
+	}
+	c.done[key] = true
+	return nil
+}
+
+// Close closes the checkpoint file
+func (c *FileCheckpoint) Close() error {
+	return c.file.Close()
+}
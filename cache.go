@@ -0,0 +1,207 @@
+package dvb
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// Cache stores raw API responses until they expire. Implementations must be safe
+// for concurrent use. See NewMemoryCache for the in-memory implementation.
+type Cache interface {
+	// Get returns the entry stored under key, if any. Expired entries may be returned.
+	Get(key string) (CacheEntry, bool)
+
+	// Set stores the entry under key
+	Set(key string, entry CacheEntry)
+}
+
+// CacheEntry is a cached API response
+type CacheEntry struct {
+	// Body is the raw JSON response body
+	Body []byte
+
+	// Expires is the response's ExpirationTime
+	Expires time.Time
+}
+
+// expiring is implemented by responses carrying an ExpirationTime
+type expiring interface {
+	ExpiresAt() time.Time
+}
+
+// staleMarker is implemented by cacheable responses carrying a Stale flag
+type staleMarker interface {
+	markStale()
+}
+
+func (r *MonitorStopResponse) markStale() { r.Stale = true }
+func (r *GetLinesResponse) markStale()    { r.Stale = true }
+func (r *GetPointResponse) markStale()    { r.Stale = true }
+
+// revalidations tracks the cache keys refreshed in the background, so concurrent
+// requests for the same stale entry trigger only one refresh
+type revalidations struct {
+	mu   sync.Mutex
+	keys map[string]bool
+}
+
+// start reports whether the caller should refresh key, marking it as in progress
+func (r *revalidations) start(key string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.keys[key] {
+		return false
+	}
+	r.keys[key] = true
+	return true
+}
+
+func (r *revalidations) done(key string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.keys, key)
+}
+
+// doCachedRequest performs the request and decodes the response into target, serving
+// it from the cache while the previously received response has not expired yet.
+// Responses are only cached if they carry an ExpirationTime in the future.
+//
+// If Config.StaleWhileRevalidate is set, expired responses are still served for
+// that long, marked as stale, while the request is repeated in the background.
+func (c *Client) doCachedRequest(ctx context.Context, opts requestOptions, target expiring) error {
+	if c.cache == nil {
+		resp, err := c.doRequest(ctx, opts)
+		if err != nil {
+			return err
+		}
+		return c.handleResponse(resp, target)
+	}
+
+	opts = opts.withSettings(ctx)
+	key := opts.Method + " " + opts.Path + "?" + c.encoding.encode(opts.Query)
+	if entry, ok := c.cache.Get(key); ok {
+		now := time.Now()
+		fresh := now.Before(entry.Expires)
+		stale := !fresh && c.staleFor > 0 && now.Before(entry.Expires.Add(c.staleFor))
+		if fresh || stale {
+			if err := json.Unmarshal(entry.Body, target); err == nil {
+				c.metrics.CacheLookup(opts.Path, true)
+				if stale {
+					if marker, ok := target.(staleMarker); ok {
+						marker.markStale()
+					}
+					c.revalidate(ctx, opts, key, reflect.TypeOf(target).Elem())
+				}
+				return nil
+			}
+		}
+	}
+	c.metrics.CacheLookup(opts.Path, false)
+
+	return c.fetchCached(ctx, opts, key, target)
+}
+
+// fetchCached performs the request, decodes the response into target and stores
+// it in the cache under key
+func (c *Client) fetchCached(ctx context.Context, opts requestOptions, key string, target expiring) error {
+	resp, err := c.doRequest(ctx, opts)
+	if err != nil {
+		return err
+	}
+	body, err := c.readResponse(resp)
+	if err != nil {
+		return err
+	}
+	if err := decodeResponse(body, opts.Query, target); err != nil {
+		return err
+	}
+
+	if expires := target.ExpiresAt(); expires.After(time.Now()) {
+		c.cache.Set(key, CacheEntry{Body: body, Expires: expires})
+	}
+	return nil
+}
+
+// revalidate refreshes the cache entry under key in the background, decoding into a
+// new value of targetType. The refresh outlives ctx, keeping its values (e.g. request
+// options and the trace) but not its cancellation. Failures are dropped; the stale
+// entry is served until a later refresh succeeds or it is no longer usable.
+func (c *Client) revalidate(ctx context.Context, opts requestOptions, key string, targetType reflect.Type) {
+	if !c.revalidating.start(key) {
+		return
+	}
+
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		defer c.revalidating.done(key)
+		target := reflect.New(targetType).Interface().(expiring)
+		_ = c.fetchCached(ctx, opts, key, target)
+	}()
+}
+
+// MemoryCache is an in-memory Cache holding a bounded number of entries.
+type MemoryCache struct {
+	maxEntries int
+
+	mu      sync.Mutex
+	entries map[string]CacheEntry
+}
+
+// NewMemoryCache creates an in-memory cache holding up to maxEntries responses
+// (defaults to 1000 if not positive). When full, expired entries are evicted
+// first, then the entry expiring soonest.
+//
+// Example usage:
+//
+//	client := dvb.NewClient(dvb.Config{
+//		Cache: dvb.NewMemoryCache(0),
+//	})
+func NewMemoryCache(maxEntries int) *MemoryCache {
+	if maxEntries <= 0 {
+		maxEntries = 1000
+	}
+	return &MemoryCache{
+		maxEntries: maxEntries,
+		entries:    make(map[string]CacheEntry),
+	}
+}
+
+// Get returns the entry stored under key
+func (m *MemoryCache) Get(key string) (CacheEntry, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entry, ok := m.entries[key]
+	return entry, ok
+}
+
+// Set stores the entry under key, evicting entries if the cache is full
+func (m *MemoryCache) Set(key string, entry CacheEntry) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.entries[key]; !ok && len(m.entries) >= m.maxEntries {
+		m.evict()
+	}
+	m.entries[key] = entry
+}
+
+// evict removes expired entries, or the entry expiring soonest if none has expired. Requires m.mu.
+func (m *MemoryCache) evict() {
+	now := time.Now()
+	var soonest string
+	for key, entry := range m.entries {
+		if now.After(entry.Expires) {
+			delete(m.entries, key)
+			continue
+		}
+		if soonest == "" || entry.Expires.Before(m.entries[soonest].Expires) {
+			soonest = key
+		}
+	}
+	if len(m.entries) >= m.maxEntries && soonest != "" {
+		delete(m.entries, soonest)
+	}
+}
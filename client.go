@@ -8,30 +8,142 @@
 //	response, err := client.MonitorStop(ctx, &dvb.MonitorStopParams{
 //		StopId: "33000028", // Dresden Hauptbahnhof
 //	})
+//
+// Every API method takes its parameters as a single *Params struct (e.g.
+// MonitorStopParams), the only parameter type of the call. Shorthand methods like
+// Departures take the same parameters as functional Options instead, and all
+// methods accept RequestOptions (RequestHeader, RequestTimeout, ...) to override
+// client defaults for a single call.
 package dvb
 
 import (
+	"context"
+	"net"
 	"net/http"
 	"time"
+
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Client represents a DVB API client with configuration for making requests.
 type Client struct {
-	baseURL    string
-	httpClient *http.Client
-	userAgent  string
+	endpoints      *endpointPool
+	httpClient     *http.Client
+	userAgent      string
+	bearerToken    string
+	apiKey         string
+	apiKeyHeader   string
+	tokenSource    TokenSource
+	headers        map[string]string
+	scheduleSource ScheduleSource
+	encoding       EncodingOptions
+	metrics        MetricsRecorder
+	rateLimiter    *rateLimiter
+	tenant         *tenantGate
+	cache          Cache
+	staleFor       time.Duration
+	revalidating   *revalidations
+	suggestions    *suggestCache
+	retryPolicy    RetryPolicy
+	tracer         trace.Tracer
+
+	disableCompression bool
 }
 
 // Config holds configuration options for creating a new DVB client.
 type Config struct {
-	BaseURL    string        // Base URL for the DVB API (optional, defaults to official API)
-	UserAgent  string        // User agent string for requests (optional)
-	Timeout    time.Duration // HTTP timeout for requests (optional, defaults to 30s)
-	HTTPClient *http.Client  // Custom HTTP client (optional)
+	BaseURL      string        // Base URL for the DVB API, may include a path prefix (optional, defaults to official API)
+	UserAgent    string        // User agent string for requests (optional)
+	Timeout      time.Duration // HTTP timeout for requests (optional, defaults to 30s)
+	HTTPClient   *http.Client  // Custom HTTP client (optional)
+	BearerToken  string        // Static bearer token sent in the Authorization header (optional)
+	APIKey       string        // API key sent with every request (optional)
+	APIKeyHeader string        // Header carrying APIKey (optional, defaults to X-API-Key)
+	TokenSource  TokenSource   // Provides bearer tokens per request, takes precedence over BearerToken (optional)
+
+	// Headers are default headers sent with every request (optional).
+	// Headers set for an individual request take precedence.
+	Headers map[string]string
+
+	// Mirrors are additional base URLs (e.g. caching relays) used when the BaseURL
+	// fails with a network error or 5xx response (optional). Failed endpoints are
+	// skipped for FailoverCooldown and then tried again.
+	Mirrors []string
+
+	// FailoverCooldown is how long a failed endpoint is avoided (optional, defaults to 30s)
+	FailoverCooldown time.Duration
+
+	// ScheduleFallback answers MonitorStop from static schedule data when the API
+	// is unreachable or fails with a server error (optional), see ScheduleSource
+	ScheduleFallback ScheduleSource
+
+	// RateLimit caps the number of requests per second sent upstream; requests
+	// exceeding it wait for their turn (optional, unlimited if 0)
+	RateLimit float64
+
+	// RateLimitBurst is the number of requests that may be sent at once before
+	// RateLimit applies (optional, defaults to 1)
+	RateLimitBurst int
+
+	// Metrics receives request, cache, retry and poller events (optional)
+	Metrics MetricsRecorder
+
+	// RetryPolicy retries transient failures such as network errors and 5xx
+	// responses with exponential backoff (optional, no retries by default)
+	RetryPolicy RetryPolicy
+
+	// TracerProvider creates an OpenTelemetry client span for every API call,
+	// carrying the endpoint, stop ID and response status code (optional, tracing
+	// is disabled if nil)
+	TracerProvider trace.TracerProvider
+
+	// Cache stores MonitorStop, GetLines, GetPoint and GetTimetable responses and serves repeated
+	// requests from it until the response's ExpirationTime passes (optional,
+	// disabled if nil), see NewMemoryCache
+	Cache Cache
+
+	// StaleWhileRevalidate serves cached responses for up to this long after they
+	// expired, flagged as Stale, while a fresh response is fetched in the
+	// background, e.g. so kiosk displays never go blank (optional, disabled if 0).
+	// Requires Cache.
+	StaleWhileRevalidate time.Duration
+
+	// Encoding controls how query parameters are serialized (optional, defaults to url.Values.Encode)
+	Encoding EncodingOptions
+
+	// DialContext is used to open connections, e.g. through an SSH tunnel or SOCKS proxy (optional).
+	// Ignored if HTTPClient is set.
+	DialContext func(ctx context.Context, network, addr string) (net.Conn, error)
+
+	// UnixSocket routes all requests through the Unix domain socket at this path,
+	// e.g. a local sidecar (optional). Takes precedence over DialContext and is
+	// ignored if HTTPClient is set.
+	UnixSocket string
+
+	// DisableCompression stops requesting gzip or deflate compressed responses
+	// (optional, compression is enabled by default)
+	DisableCompression bool
+
+	// Transport tunes connection pooling, timeouts, TLS and the proxy of the
+	// default HTTP client (optional), see TransportOptions. Ignored if HTTPClient is set.
+	Transport TransportOptions
+}
+
+// ScheduleSource provides scheduled departures from static data, e.g. a loaded GTFS feed.
+// Implementations return up to limit departures (all if limit is 0) at the stop
+// with the given DVB API stop ID, starting at from.
+type ScheduleSource interface {
+	ScheduledDepartures(stopId string, from time.Time, limit int) (*MonitorStopResponse, error)
 }
 
+// TokenSource returns the bearer token to use for a request. It is called before
+// every request, so implementations are expected to cache the token and refresh
+// it when it is about to expire.
+type TokenSource func(ctx context.Context) (string, error)
+
 // NewClient creates a new DVB API client with the provided configuration.
 // If no configuration is provided, sensible defaults will be used.
+// Use Config.WithEnv to let DVB_* environment variables override the configuration.
 func NewClient(config Config) *Client {
 	if config.BaseURL == "" {
 		config.BaseURL = "https://webapi.vvo-online.de"
@@ -48,13 +160,53 @@ func NewClient(config Config) *Client {
 	httpClient := config.HTTPClient
 	if httpClient == nil {
 		httpClient = &http.Client{
-			Timeout: config.Timeout,
+			Timeout:   config.Timeout,
+			Transport: newTransport(config),
 		}
 	}
 
+	if config.FailoverCooldown == 0 {
+		config.FailoverCooldown = 30 * time.Second
+	}
+
+	if config.APIKeyHeader == "" {
+		config.APIKeyHeader = "X-API-Key"
+	}
+
+	if config.Metrics == nil {
+		config.Metrics = nopMetrics{}
+	}
+
+	var tracer trace.Tracer
+	if config.TracerProvider != nil {
+		tracer = config.TracerProvider.Tracer(tracerName)
+	}
+
+	headers := make(map[string]string, len(config.Headers))
+	for key, value := range config.Headers {
+		headers[key] = value
+	}
+
 	return &Client{
-		baseURL:    config.BaseURL,
-		httpClient: httpClient,
-		userAgent:  config.UserAgent,
+		endpoints:      newEndpointPool(append([]string{config.BaseURL}, config.Mirrors...), config.FailoverCooldown),
+		httpClient:     httpClient,
+		userAgent:      config.UserAgent,
+		bearerToken:    config.BearerToken,
+		apiKey:         config.APIKey,
+		apiKeyHeader:   config.APIKeyHeader,
+		tokenSource:    config.TokenSource,
+		headers:        headers,
+		scheduleSource: config.ScheduleFallback,
+		encoding:       config.Encoding,
+		metrics:        config.Metrics,
+		rateLimiter:    newRateLimiter(config.RateLimit, config.RateLimitBurst),
+		cache:          config.Cache,
+		staleFor:       config.StaleWhileRevalidate,
+		revalidating:   &revalidations{keys: make(map[string]bool)},
+		suggestions:    newSuggestCache(),
+		retryPolicy:    config.RetryPolicy.withDefaults(),
+		tracer:         tracer,
+
+		disableCompression: config.DisableCompression,
 	}
 }
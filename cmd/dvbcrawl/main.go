@@ -0,0 +1,129 @@
+// Command dvbcrawl crawls all stops of the network, their lines and the lines'
+// stop sequences into a JSON dataset (see package snapshot). If the output file
+// already exists, it is refreshed incrementally. With -gtfs-out, the departures
+// of all crawled stops within -window are additionally exported as minimal GTFS
+// static feed (see snapshot.TimetableCrawler).
+//
+// Usage:
+//
+//	dvbcrawl [-out network.json] [-gtfs VVO_GTFS.zip] [-max-age 168h] [-workers 4] [-rate 5]
+//	         [-gtfs-out snapshot.zip] [-window 1h] [stop IDs...]
+//
+// Without stop IDs, all stations of the GTFS feed are crawled. The feed is
+// downloaded from the VVO open data portal unless -gtfs points to a local file.
+// Client settings are read from DVB_* environment variables.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"io/fs"
+	"log"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/niclaszll/dvb-go"
+	"github.com/niclaszll/dvb-go/gtfs"
+	"github.com/niclaszll/dvb-go/snapshot"
+)
+
+func main() {
+	out := flag.String("out", "network.json", "dataset file to write, refreshed incrementally if it exists")
+	feedPath := flag.String("gtfs", "", "local GTFS feed (downloaded if empty)")
+	maxAge := flag.Duration("max-age", 7*24*time.Hour, "keep stops crawled more recently than this (0 to crawl all)")
+	workers := flag.Int("workers", 4, "number of concurrent requests")
+	rate := flag.Float64("rate", 5, "maximum requests per second (0 for unlimited)")
+	gtfsOut := flag.String("gtfs-out", "", "also export the departures of the crawled stops as GTFS feed to this file")
+	window := flag.Duration("window", time.Hour, "time window of departures exported with -gtfs-out, starting now")
+	flag.Parse()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	config, err := dvb.Config{}.WithEnv()
+	if err != nil {
+		log.Fatalf("Error reading environment: %v", err)
+	}
+	if config.RateLimit == 0 {
+		// The GTFS export sends several requests per stop, so limit the client itself
+		config.RateLimit = *rate
+	}
+	client := dvb.NewClient(config)
+
+	var feed *gtfs.Feed
+	if *feedPath != "" {
+		feed, err = gtfs.LoadFile(*feedPath)
+	} else {
+		feed, err = gtfs.Download(ctx, nil, gtfs.DefaultFeedURL)
+	}
+	if err != nil {
+		log.Fatalf("Error loading GTFS feed: %v", err)
+	}
+
+	previous, err := snapshot.LoadFile(*out)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		log.Fatalf("Error loading previous dataset: %v", err)
+	}
+
+	crawler := &snapshot.Crawler{
+		Client: client,
+		Feed:   feed,
+		MaxAge: *maxAge,
+		Bulk: dvb.BulkOptions{
+			Workers:   *workers,
+			RateLimit: *rate,
+			Progress: func(progress dvb.BulkProgress) {
+				if progress.Finished()%100 == 0 || progress.Finished() == progress.Total {
+					log.Printf("Crawled %d/%d stops (%d failed)", progress.Finished(), progress.Total, progress.Failed)
+				}
+			},
+		},
+		Errors: func(stopId string, err error) {
+			log.Printf("Error crawling stop %s: %v", stopId, err)
+		},
+	}
+
+	dataset, err := crawler.Crawl(ctx, flag.Args(), previous)
+	if err != nil {
+		log.Fatalf("Error crawling network: %v", err)
+	}
+
+	if err := dataset.SaveFile(*out); err != nil {
+		log.Fatalf("Error saving dataset: %v", err)
+	}
+	log.Printf("Wrote %d stops and %d lines to %s", len(dataset.Stops), len(dataset.Lines), *out)
+
+	if *gtfsOut == "" {
+		return
+	}
+
+	stopIds := make([]string, 0, len(dataset.Stops))
+	for stopId := range dataset.Stops {
+		stopIds = append(stopIds, stopId)
+	}
+	timetable := &snapshot.TimetableCrawler{
+		Client: client,
+		Window: *window,
+		Bulk: dvb.BulkOptions{
+			Workers: *workers,
+			Progress: func(progress dvb.BulkProgress) {
+				if progress.Finished()%100 == 0 || progress.Finished() == progress.Total {
+					log.Printf("Exported departures of %d/%d stops", progress.Finished(), progress.Total)
+				}
+			},
+		},
+		Errors: func(stopId string, err error) {
+			log.Printf("Error exporting departures of stop %s: %v", stopId, err)
+		},
+	}
+	exported, err := timetable.Crawl(ctx, stopIds)
+	if err != nil {
+		log.Fatalf("Error exporting timetable: %v", err)
+	}
+	if err := exported.SaveFile(*gtfsOut); err != nil {
+		log.Fatalf("Error saving GTFS feed: %v", err)
+	}
+	log.Printf("Wrote %d trips at %d stops to %s", len(exported.Trips), len(exported.Stops), *gtfsOut)
+}
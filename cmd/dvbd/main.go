@@ -0,0 +1,148 @@
+// Command dvbd runs the dvb server mode as a long-running daemon. It integrates
+// with systemd (readiness and watchdog notifications) and shuts down gracefully
+// on SIGTERM or SIGINT.
+//
+// Usage:
+//
+//	dvbd [-config dvbd.yaml] [-addr :8080]
+//
+// The configuration file (YAML or TOML, see package dvbconfig) describes client
+// settings, the listen address, stops to watch, notification backends and the
+// MQTT broker the watched stops are published to.
+// DVB_* environment variables (e.g. DVB_BASE_URL, DVB_TIMEOUT, DVB_RATE_LIMIT,
+// DVB_ADDR) override the file; command-line flags override both.
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/niclaszll/dvb-go"
+	"github.com/niclaszll/dvb-go/dvbconfig"
+	"github.com/niclaszll/dvb-go/dvbgrpc"
+	"github.com/niclaszll/dvb-go/dvbserver"
+	"github.com/niclaszll/dvb-go/systemd"
+)
+
+func main() {
+	configPath := flag.String("config", "", "path to a YAML or TOML configuration file")
+	addr := flag.String("addr", "", "address to listen on (overrides the configuration file)")
+	flag.Parse()
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, os.Interrupt)
+	defer stop()
+
+	config := &dvbconfig.Config{}
+	if *configPath != "" {
+		var err error
+		config, err = dvbconfig.Load(*configPath)
+		if err != nil {
+			log.Fatalf("Error loading configuration: %v", err)
+		}
+	} else if err := config.ApplyEnv(); err != nil {
+		log.Fatalf("Error reading environment: %v", err)
+	}
+	if *addr != "" {
+		config.Server.Addr = *addr
+	}
+	if config.Server.Addr == "" {
+		config.Server.Addr = ":8080"
+	}
+
+	if config.Client.CacheDir != "" {
+		// Drop entries left over from long ago, e.g. before the board was powered off
+		if err := dvb.NewFileCache(config.Client.CacheDir).Prune(24 * time.Hour); err != nil {
+			log.Printf("Error pruning cache: %v", err)
+		}
+	}
+
+	client := dvb.NewClient(config.Client.DVBConfig())
+	server := dvbserver.New(client, config.Server.Options())
+
+	registry, err := config.Registry()
+	if err != nil {
+		log.Fatalf("Error setting up notifiers: %v", err)
+	}
+	for _, watch := range config.Watch {
+		go runWatch(ctx, client, registry, watch)
+	}
+
+	publisher, err := config.Publisher(client)
+	if err != nil {
+		log.Fatalf("Error setting up MQTT: %v", err)
+	}
+	if publisher != nil {
+		if err := publisher.Start(ctx); err != nil {
+			log.Fatalf("Error starting MQTT publisher: %v", err)
+		}
+		defer publisher.Stop()
+	}
+
+	watcher, err := config.DisruptionWatcher(client, func(err error) {
+		log.Printf("Disruptions: %v", err)
+	})
+	if err != nil {
+		log.Fatalf("Error setting up disruption webhooks: %v", err)
+	}
+	if watcher != nil {
+		if err := watcher.Start(ctx); err != nil {
+			log.Fatalf("Error starting disruption watcher: %v", err)
+		}
+		defer watcher.Stop()
+	}
+
+	if config.Server.GRPCAddr != "" {
+		go serveGRPC(ctx, client, config.Server.GRPCAddr)
+	}
+
+	listener, err := net.Listen("tcp", config.Server.Addr)
+	if err != nil {
+		log.Fatalf("Error listening on %s: %v", config.Server.Addr, err)
+	}
+
+	systemd.Notify(systemd.Ready)
+	systemd.Status("serving on " + listener.Addr().String())
+	// Stop the keep-alives while readiness fails, so systemd restarts a hung daemon
+	go systemd.RunWatchdog(ctx, func() error {
+		return server.Ready(ctx)
+	})
+	go func() {
+		<-ctx.Done()
+		systemd.Notify(systemd.Stopping)
+	}()
+
+	log.Printf("Serving on %s", listener.Addr())
+	if err := server.Serve(ctx, listener); err != nil {
+		log.Fatalf("Error serving: %v", err)
+	}
+
+	log.Println("Shut down")
+}
+
+// serveGRPC serves the gRPC service on addr until ctx is cancelled
+func serveGRPC(ctx context.Context, client *dvb.Client, addr string) {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		log.Fatalf("Error listening on %s: %v", addr, err)
+	}
+
+	server := grpc.NewServer()
+	dvbgrpc.Register(server, client)
+	go func() {
+		<-ctx.Done()
+		server.GracefulStop()
+	}()
+
+	log.Printf("Serving gRPC on %s", listener.Addr())
+	if err := server.Serve(listener); err != nil {
+		log.Fatalf("Error serving gRPC: %v", err)
+	}
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/niclaszll/dvb-go"
+	"github.com/niclaszll/dvb-go/dvbconfig"
+	"github.com/niclaszll/dvb-go/notify"
+)
+
+// runWatch polls a watched stop and dispatches delay and cancellation alerts until ctx is done
+func runWatch(ctx context.Context, client *dvb.Client, registry *notify.Registry, watch dvbconfig.Watch) {
+	ticker := time.NewTicker(time.Duration(watch.Interval))
+	defer ticker.Stop()
+
+	shortTermChanges := true
+	for {
+		response, err := client.MonitorStop(ctx, &dvb.MonitorStopParams{
+			StopId:           watch.Stop,
+			ShortTermChanges: &shortTermChanges,
+		})
+		if err != nil && !errors.Is(err, dvb.ErrNoDepartures) && ctx.Err() == nil {
+			log.Printf("Error monitoring stop %s: %v", watch.Stop, err)
+		}
+		if err == nil {
+			for _, departure := range response.Departures {
+				if len(watch.Lines) > 0 && !slices.Contains(watch.Lines, departure.LineName) {
+					continue
+				}
+				if event, ok := alert(watch, response.Name, departure); ok {
+					if err := registry.Dispatch(ctx, event); err != nil {
+						log.Printf("Error sending notification: %v", err)
+					}
+				}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// alert builds an event for a cancelled or sufficiently delayed departure
+func alert(watch dvbconfig.Watch, stopName string, departure dvb.Departure) (notify.Event, bool) {
+	event := notify.Event{
+		StopId: watch.Stop,
+		Line:   departure.LineName,
+		Key:    fmt.Sprintf("%s|%s|%s", watch.Stop, departure.Id, departure.State),
+	}
+
+	if departure.IsCancelled() {
+		event.Kind = notify.KindCancellation
+		event.Priority = notify.PriorityHigh
+		event.Title = fmt.Sprintf("Line %s cancelled", departure.LineName)
+		event.Message = fmt.Sprintf("Line %s to %s at %s is cancelled", departure.LineName, departure.Direction, stopName)
+		return event, true
+	}
+
+	if departure.ScheduledTime.IsZero() || departure.RealTime.IsZero() {
+		return event, false
+	}
+	realTime := departure.RealTime.Time
+	delay := departure.Delay()
+	if delay < time.Duration(watch.MinDelay) {
+		return event, false
+	}
+
+	event.Kind = notify.KindDelay
+	event.Title = fmt.Sprintf("Line %s delayed", departure.LineName)
+	event.Message = fmt.Sprintf("Line %s to %s at %s departs %d min late (%s)",
+		departure.LineName, departure.Direction, stopName, int(delay.Minutes()), realTime.Format("15:04"))
+	event.Key = fmt.Sprintf("%s|%s|delay", watch.Stop, departure.Id)
+	return event, true
+}
@@ -0,0 +1,78 @@
+package dvb
+
+import (
+	"bufio"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// acceptEncoding lists the content encodings decompressed by decompressResponse
+const acceptEncoding = "gzip, deflate"
+
+// decompressResponse transparently decodes gzip and deflate response bodies. The
+// standard transport already does this for gzip if Accept-Encoding is not set
+// explicitly, in which case resp.Uncompressed is true and resp is left unchanged.
+func decompressResponse(resp *http.Response) {
+	if resp.Uncompressed {
+		return
+	}
+
+	encoding := strings.ToLower(strings.TrimSpace(resp.Header.Get("Content-Encoding")))
+	if encoding != "gzip" && encoding != "deflate" {
+		return
+	}
+
+	resp.Body = &decompressingBody{body: resp.Body, encoding: encoding}
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+}
+
+// decompressingBody decodes a compressed body lazily on the first Read, so empty
+// bodies (e.g. of error responses) do not fail before they are read
+type decompressingBody struct {
+	body     io.ReadCloser
+	encoding string
+	reader   io.Reader
+	err      error
+}
+
+func (b *decompressingBody) Read(p []byte) (int, error) {
+	if b.reader == nil && b.err == nil {
+		b.reader, b.err = b.open()
+	}
+	if b.err != nil {
+		return 0, b.err
+	}
+	return b.reader.Read(p)
+}
+
+// open creates the decoder of the body's encoding. Deflate bodies are usually
+// zlib-wrapped as required by RFC 9110, but some servers send raw deflate data.
+func (b *decompressingBody) open() (io.Reader, error) {
+	if b.encoding == "gzip" {
+		return gzip.NewReader(b.body)
+	}
+
+	buffered := bufio.NewReader(b.body)
+	header, err := buffered.Peek(2)
+	if len(header) == 0 {
+		return nil, err
+	}
+	if len(header) == 2 && header[0]&0x0f == 8 && (uint16(header[0])<<8|uint16(header[1]))%31 == 0 {
+		return zlib.NewReader(buffered)
+	}
+	return flate.NewReader(buffered), nil
+}
+
+func (b *decompressingBody) Close() error {
+	if closer, ok := b.reader.(io.Closer); ok {
+		closer.Close()
+	}
+	return b.body.Close()
+}
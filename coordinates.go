@@ -0,0 +1,30 @@
+package dvb
+
+import "github.com/niclaszll/dvb-go/coords"
+
+// wgs84 converts GK4 coordinates as returned by the API, reporting false if they are unknown
+func wgs84(x, y int) (lat, lon float64, ok bool) {
+	if x == 0 || y == 0 {
+		return 0, 0, false
+	}
+	lat, lon = coords.GK4ToWGS84(float64(x), float64(y))
+	return lat, lon, true
+}
+
+// WGS84 returns the stop's position as WGS84 latitude and longitude in degrees.
+// ok is false if the API returned no coordinates for the stop.
+func (s *RegularStop) WGS84() (lat, lon float64, ok bool) {
+	return wgs84(s.Latitude, s.Longitude)
+}
+
+// WGS84 returns the stop's position as WGS84 latitude and longitude in degrees.
+// ok is false if the API returned no coordinates for the stop.
+func (s *TripStop) WGS84() (lat, lon float64, ok bool) {
+	return wgs84(s.Latitude, s.Longitude)
+}
+
+// WGS84 returns the point's position as WGS84 latitude and longitude in degrees.
+// ok is false if the API returned no coordinates for the point.
+func (p *Point) WGS84() (lat, lon float64, ok bool) {
+	return wgs84(p.X, p.Y)
+}
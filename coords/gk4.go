@@ -0,0 +1,157 @@
+// Package coords converts between the Gauss-Krüger zone 4 coordinates used by the
+// API (e.g. RegularStop.Latitude/Longitude, point results and MapData) and WGS84
+// latitude/longitude as used by web maps and GPS.
+//
+// The API reports GK4 coordinates as integer pairs of northing (x, ~5.6 million)
+// and easting (y, ~4.6 million). Conversions include the DHDN → WGS84 datum shift
+// and are accurate to a few meters.
+//
+// Example usage:
+//
+//	lat, lon := coords.GK4ToWGS84(5655904, 4621157)
+//	fmt.Printf("%.5f, %.5f\n", lat, lon)
+package coords
+
+import "math"
+
+// Bessel 1841 ellipsoid used by the DHDN datum of Gauss-Krüger coordinates
+const (
+	besselA = 6377397.155
+	besselF = 1 / 299.1528128
+)
+
+// WGS84 ellipsoid
+const (
+	wgs84A = 6378137.0
+	wgs84F = 1 / 298.257223563
+)
+
+// Zone 4 projection parameters
+const (
+	centralMeridian = 12 * math.Pi / 180
+	falseEasting    = 4500000
+)
+
+// Helmert parameters DHDN → WGS84 for Germany (EPSG:1777, position vector convention)
+const (
+	arcsec  = math.Pi / 180 / 3600
+	shiftTx = 598.1
+	shiftTy = 73.7
+	shiftTz = 418.2
+	shiftRx = 0.202 * arcsec
+	shiftRy = 0.045 * arcsec
+	shiftRz = -2.455 * arcsec
+	shiftS  = 6.7e-6
+)
+
+// GK4ToWGS84 converts Gauss-Krüger zone 4 coordinates (northing x, easting y)
+// to WGS84 latitude and longitude in degrees.
+func GK4ToWGS84(x, y float64) (lat, lon float64) {
+	phi, lambda := inverseTransverseMercator(x, y)
+	px, py, pz := toECEF(phi, lambda, besselA, besselF)
+	px, py, pz = helmert(px, py, pz, 1)
+	phi, lambda = fromECEF(px, py, pz, wgs84A, wgs84F)
+	return phi * 180 / math.Pi, lambda * 180 / math.Pi
+}
+
+// WGS84ToGK4 converts WGS84 latitude and longitude in degrees to Gauss-Krüger
+// zone 4 coordinates (northing x, easting y).
+func WGS84ToGK4(lat, lon float64) (x, y float64) {
+	px, py, pz := toECEF(lat*math.Pi/180, lon*math.Pi/180, wgs84A, wgs84F)
+	px, py, pz = helmert(px, py, pz, -1)
+	phi, lambda := fromECEF(px, py, pz, besselA, besselF)
+	return transverseMercator(phi, lambda)
+}
+
+// krueger returns the third flattening and the rectifying radius of the Bessel ellipsoid
+func krueger() (n, a float64) {
+	n = besselF / (2 - besselF)
+	return n, besselA / (1 + n) * (1 + n*n/4 + n*n*n*n/64)
+}
+
+// transverseMercator projects geodetic coordinates in radians on the Bessel
+// ellipsoid to zone 4 (Krüger series)
+func transverseMercator(phi, lambda float64) (x, y float64) {
+	n, a := krueger()
+	alpha := [3]float64{
+		n/2 - 2*n*n/3 + 5*n*n*n/16,
+		13*n*n/48 - 3*n*n*n/5,
+		61 * n * n * n / 240,
+	}
+
+	k := 2 * math.Sqrt(n) / (1 + n)
+	t := math.Sinh(math.Atanh(math.Sin(phi)) - k*math.Atanh(k*math.Sin(phi)))
+	dLambda := lambda - centralMeridian
+	xi := math.Atan(t / math.Cos(dLambda))
+	eta := math.Atanh(math.Sin(dLambda) / math.Sqrt(1+t*t))
+
+	x, y = xi, eta
+	for j, al := range alpha {
+		m := 2 * float64(j+1)
+		x += al * math.Sin(m*xi) * math.Cosh(m*eta)
+		y += al * math.Cos(m*xi) * math.Sinh(m*eta)
+	}
+	return a * x, falseEasting + a*y
+}
+
+// inverseTransverseMercator converts zone 4 coordinates to geodetic coordinates
+// in radians on the Bessel ellipsoid (Krüger series)
+func inverseTransverseMercator(x, y float64) (phi, lambda float64) {
+	n, a := krueger()
+	xi := x / a
+	eta := (y - falseEasting) / a
+
+	beta := [3]float64{
+		n/2 - 2*n*n/3 + 37*n*n*n/96,
+		n*n/48 + n*n*n/15,
+		17 * n * n * n / 480,
+	}
+	xiP, etaP := xi, eta
+	for j, b := range beta {
+		m := 2 * float64(j+1)
+		xiP -= b * math.Sin(m*xi) * math.Cosh(m*eta)
+		etaP -= b * math.Cos(m*xi) * math.Sinh(m*eta)
+	}
+	chi := math.Asin(math.Sin(xiP) / math.Cosh(etaP))
+	delta := [3]float64{
+		2*n - 2*n*n/3 - 2*n*n*n,
+		7*n*n/3 - 8*n*n*n/5,
+		56 * n * n * n / 15,
+	}
+	phi = chi
+	for j, d := range delta {
+		phi += d * math.Sin(2*float64(j+1)*chi)
+	}
+	return phi, centralMeridian + math.Atan(math.Sinh(etaP)/math.Cos(xiP))
+}
+
+// helmert applies the datum shift DHDN → WGS84 (direction 1) or its
+// approximate inverse WGS84 → DHDN (direction -1)
+func helmert(x, y, z, direction float64) (float64, float64, float64) {
+	d := direction
+	s := 1 + d*shiftS
+	rx, ry, rz := d*shiftRx, d*shiftRy, d*shiftRz
+	return d*shiftTx + s*(x-rz*y+ry*z),
+		d*shiftTy + s*(rz*x+y-rx*z),
+		d*shiftTz + s*(-ry*x+rx*y+z)
+}
+
+// toECEF converts geodetic coordinates in radians at height 0 to earth-centered cartesian coordinates
+func toECEF(phi, lambda, a, f float64) (x, y, z float64) {
+	e2 := f * (2 - f)
+	n := a / math.Sqrt(1-e2*math.Sin(phi)*math.Sin(phi))
+	return n * math.Cos(phi) * math.Cos(lambda), n * math.Cos(phi) * math.Sin(lambda), n * (1 - e2) * math.Sin(phi)
+}
+
+// fromECEF converts earth-centered cartesian coordinates to geodetic coordinates in radians
+func fromECEF(x, y, z, a, f float64) (phi, lambda float64) {
+	e2 := f * (2 - f)
+	p := math.Hypot(x, y)
+	phi = math.Atan2(z, p*(1-e2))
+	for range 5 {
+		n := a / math.Sqrt(1-e2*math.Sin(phi)*math.Sin(phi))
+		h := p/math.Cos(phi) - n
+		phi = math.Atan2(z, p*(1-e2*n/(n+h)))
+	}
+	return phi, math.Atan2(y, x)
+}
@@ -0,0 +1,64 @@
+package dvb
+
+import (
+	"fmt"
+	"time"
+)
+
+// Language selects the language of texts formatted by this package
+type Language string
+
+const (
+	English Language = "en"
+	German  Language = "de"
+)
+
+// In returns the time remaining until the departure at now, based on the expected
+// departure time (see ExpectedTime). The result is negative if the departure time
+// has passed.
+func (d *Departure) In(now time.Time) time.Duration {
+	return d.ExpectedTime().Sub(now)
+}
+
+// Countdown formats the time remaining until the departure at now for departure
+// boards, see FormatCountdown.
+//
+// Example usage:
+//
+//	for _, departure := range response.Departures {
+//		fmt.Printf("%-4s %-20s %s\n", departure.LineName, departure.Direction,
+//			departure.Countdown(time.Now(), dvb.German))
+//	}
+func (d *Departure) Countdown(now time.Time, lang Language) string {
+	return FormatCountdown(d.In(now), lang)
+}
+
+// FormatCountdown formats a remaining time like departure boards do: "now" below
+// one minute (including departures whose time has passed), "3 min" below one hour
+// and "1 h 5 min" beyond. Minutes are rounded down. German texts are "jetzt",
+// "3 Min." and "1 Std. 5 Min."; unknown languages fall back to English.
+func FormatCountdown(remaining time.Duration, lang Language) string {
+	minutes := int(remaining / time.Minute)
+	if minutes < 1 {
+		if lang == German {
+			return "jetzt"
+		}
+		return "now"
+	}
+
+	hours, minutes := minutes/60, minutes%60
+	switch {
+	case lang == German && hours == 0:
+		return fmt.Sprintf("%d Min.", minutes)
+	case lang == German && minutes == 0:
+		return fmt.Sprintf("%d Std.", hours)
+	case lang == German:
+		return fmt.Sprintf("%d Std. %d Min.", hours, minutes)
+	case hours == 0:
+		return fmt.Sprintf("%d min", minutes)
+	case minutes == 0:
+		return fmt.Sprintf("%d h", hours)
+	default:
+		return fmt.Sprintf("%d h %d min", hours, minutes)
+	}
+}
@@ -0,0 +1,117 @@
+package dvb
+
+import (
+	"sync"
+	"time"
+)
+
+// DataQuality indicates how trustworthy the real-time data of a departure board is,
+// so UIs can warn users when they might be looking at plain timetable data.
+type DataQuality string
+
+const (
+	DataQualityRealTime   DataQuality = "realtime"   // Departures carry live real-time data
+	DataQualityNoRealTime DataQuality = "norealtime" // No departure carries real-time data
+	DataQualitySuspect    DataQuality = "suspect"    // Real-time data looks frozen, e.g. everything exactly on time for long
+	DataQualityStale      DataQuality = "stale"      // The response expired long ago
+	DataQualitySchedule   DataQuality = "schedule"   // Computed from static schedule data, see ScheduledOnly
+)
+
+// staleAfterExpiration is how long after its ExpirationTime a response is considered stale
+const staleAfterExpiration = 5 * time.Minute
+
+// DataQuality classifies the real-time data of this single board snapshot at now.
+// Use a RealTimeWatchdog to also detect real-time coverage lost over time.
+func (r *MonitorStopResponse) DataQuality(now time.Time) DataQuality {
+	if r.ScheduledOnly {
+		return DataQualitySchedule
+	}
+	if expires := r.ExpiresAt(); !expires.IsZero() && now.Sub(expires) > staleAfterExpiration {
+		return DataQualityStale
+	}
+	for _, departure := range r.Departures {
+		if !departure.RealTime.IsZero() {
+			return DataQualityRealTime
+		}
+	}
+	return DataQualityNoRealTime
+}
+
+// RealTimeWatchdog detects stops whose feed appears to have lost real-time coverage
+// while still reporting real-time values, e.g. when a backend system fails and
+// every departure is reported exactly on schedule for an extended period.
+//
+// Example usage:
+//
+//	watchdog := dvb.NewRealTimeWatchdog(30 * time.Minute)
+//	response, err := client.MonitorStop(ctx, params)
+//	if err == nil && watchdog.Observe(params.StopId, response, time.Now()) != dvb.DataQualityRealTime {
+//		fmt.Println("Real-time data may be unavailable, showing timetable")
+//	}
+type RealTimeWatchdog struct {
+	// Period is how long every departure has to be exactly on time before the
+	// stop's real-time data is considered suspect
+	Period time.Duration
+
+	// MinDepartures is the number of departures with real-time data a snapshot needs
+	// to count towards the on-time period (optional, defaults to 3). Snapshots with
+	// fewer departures don't change the state.
+	MinDepartures int
+
+	mu          sync.Mutex
+	onTimeSince map[string]time.Time
+}
+
+// NewRealTimeWatchdog creates a RealTimeWatchdog that flags stops where every
+// departure has been exactly on time for the given period.
+func NewRealTimeWatchdog(period time.Duration) *RealTimeWatchdog {
+	return &RealTimeWatchdog{Period: period}
+}
+
+// Observe records a board snapshot of the stop and returns its data quality
+func (w *RealTimeWatchdog) Observe(stopId string, response *MonitorStopResponse, now time.Time) DataQuality {
+	quality := response.DataQuality(now)
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.onTimeSince == nil {
+		w.onTimeSince = make(map[string]time.Time)
+	}
+	if quality != DataQualityRealTime {
+		delete(w.onTimeSince, stopId)
+		return quality
+	}
+
+	minDepartures := w.MinDepartures
+	if minDepartures <= 0 {
+		minDepartures = 3
+	}
+
+	withRealTime, onTime := 0, true
+	for _, departure := range response.Departures {
+		if departure.RealTime.IsZero() {
+			continue
+		}
+		withRealTime++
+		if departure.Delay() != 0 {
+			onTime = false
+		}
+	}
+
+	switch {
+	case withRealTime < minDepartures:
+		// Too few departures to tell, keep the current state
+	case !onTime:
+		delete(w.onTimeSince, stopId)
+	default:
+		if _, ok := w.onTimeSince[stopId]; !ok {
+			w.onTimeSince[stopId] = now
+		}
+	}
+
+	if since, ok := w.onTimeSince[stopId]; ok && now.Sub(since) >= w.Period {
+		return DataQualitySuspect
+	}
+	return quality
+}
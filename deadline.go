@@ -0,0 +1,202 @@
+package dvb
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// LatestDepartureParams contains the parameters for finding the latest departure
+// that still arrives before a deadline.
+type LatestDepartureParams struct {
+	// Route contains the trip parameters. Origin and Destination are required;
+	// Time and IsArrivalTime are ignored in favor of Deadline.
+	Route GetRouteParams
+
+	// Deadline is the latest acceptable arrival time. This is required.
+	Deadline time.Time
+
+	// Margin is the minimum slack to keep before the deadline, e.g. to walk from
+	// the destination stop to the door. Optional parameter, defaults to 0.
+	Margin *time.Duration
+}
+
+// ArriveBy is the latest connection that makes an arrival deadline. Use Update to
+// follow it with real-time data and AtRisk to check whether the deadline is endangered.
+type ArriveBy struct {
+	Deadline time.Time
+	Margin   time.Duration
+
+	session *RouteSession
+
+	mu    sync.Mutex
+	route Route
+}
+
+// LatestDeparture finds the latest departure that still arrives by the deadline.
+// It plans with the deadline as arrival time and verifies each candidate against
+// real-time data, discarding connections that arrive late, have a cancelled
+// segment or an unreachable changeover.
+//
+// Parameters:
+//   - ctx: Context for the request, allowing for cancellation and timeouts
+//   - options: The trip parameters and the arrival deadline
+//   - requestOpts: Optional per-request overrides like headers or a timeout, see RequestOption
+//
+// Returns:
+//   - *ArriveBy: The latest connection making the deadline, ready to be monitored
+//   - error: Returns an error if the parameters are invalid or the API request fails.
+//     A *NotFoundError wrapping ErrNoRoute is returned if no connection makes the deadline.
+//
+// Example usage:
+//
+//	arriveBy, err := client.LatestDeparture(ctx, &LatestDepartureParams{
+//		Route:    GetRouteParams{Origin: "33000028", Destination: "33000016"},
+//		Deadline: meetingTime,
+//	})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	route := arriveBy.Route()
+//	fmt.Println("Leave at", route.DepartureTime().Format("15:04"))
+//	// ... later
+//	if err := arriveBy.Update(ctx); err == nil && arriveBy.AtRisk() {
+//		fmt.Println("You might be late!")
+//	}
+func (c *Client) LatestDeparture(ctx context.Context, options *LatestDepartureParams, requestOpts ...RequestOption) (*ArriveBy, error) {
+	ctx, cancel := withRequestOptions(ctx, requestOpts)
+	defer cancel()
+
+	if options == nil {
+		return nil, errors.New("options can not be nil")
+	}
+	if options.Deadline.IsZero() {
+		return nil, errors.New("deadline can not be empty")
+	}
+	margin := time.Duration(0)
+	if options.Margin != nil {
+		margin = *options.Margin
+	}
+
+	params := options.Route
+	isArrivalTime := true
+	shortTermChanges := true
+	deadline := options.Deadline.Add(-margin)
+	params.IsArrivalTime = &isArrivalTime
+	params.ShortTermChanges = &shortTermChanges
+	params.Time = &deadline
+
+	response, err := c.GetRoute(ctx, &params)
+	if err != nil {
+		return nil, err
+	}
+
+	var best *Route
+	for i := range response.Routes {
+		route := &response.Routes[i]
+		if route.Synthesized || !makesDeadline(route, options.Deadline, margin) {
+			continue
+		}
+		if best == nil || route.DepartureTime().After(best.DepartureTime()) {
+			best = route
+		}
+	}
+	if best == nil {
+		return nil, &NotFoundError{
+			Err:    ErrNoRoute,
+			Query:  params.Origin + " → " + params.Destination,
+			Status: response.Status,
+		}
+	}
+
+	session, err := c.NewRouteSession(response, best.RouteId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ArriveBy{
+		Deadline: options.Deadline,
+		Margin:   margin,
+		session:  session,
+		route:    *best,
+	}, nil
+}
+
+// Route returns the most recent state of the connection
+func (a *ArriveBy) Route() Route {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.route
+}
+
+// Slack returns the time left between the expected arrival (including the margin)
+// and the deadline. A negative value means the deadline will be missed.
+func (a *ArriveBy) Slack() time.Duration {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return slack(&a.route, a.Deadline, a.Margin)
+}
+
+// AtRisk reports whether the deadline is endangered, i.e. the connection arrives
+// too late, a segment was cancelled or a changeover is endangered.
+func (a *ArriveBy) AtRisk() bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if !makesDeadline(&a.route, a.Deadline, a.Margin) {
+		return true
+	}
+	for _, interchange := range a.route.InterchangeDetails() {
+		if interchange.Endangered {
+			return true
+		}
+	}
+	return false
+}
+
+// Update refreshes the connection with real-time data. A *NotFoundError wrapping
+// ErrNoRoute is returned if the connection is no longer offered; call
+// LatestDeparture again to find an alternative.
+func (a *ArriveBy) Update(ctx context.Context) error {
+	route, err := a.session.Update(ctx)
+	if err != nil {
+		return err
+	}
+
+	a.mu.Lock()
+	a.route = *route
+	a.mu.Unlock()
+	return nil
+}
+
+// makesDeadline reports whether the route arrives in time and can be travelled as planned
+func makesDeadline(route *Route, deadline time.Time, margin time.Duration) bool {
+	if slack(route, deadline, margin) < 0 {
+		return false
+	}
+	for i := range route.PartialRoutes {
+		partial := &route.PartialRoutes[i]
+		if !partial.isTransit() {
+			continue
+		}
+		if stop := partial.BoardingStop(); stop.DepartureState != nil && *stop.DepartureState == departureStateCancelled {
+			return false
+		}
+	}
+	for _, interchange := range route.InterchangeDetails() {
+		if interchange.Buffer < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// slack returns the deadline minus the expected arrival and margin
+func slack(route *Route, deadline time.Time, margin time.Duration) time.Duration {
+	arrival := route.ArrivalTime()
+	if arrival.IsZero() {
+		return 0
+	}
+	return deadline.Sub(arrival.Add(margin))
+}
@@ -0,0 +1,42 @@
+package dvb
+
+import "time"
+
+// Departure states reported by the API in Departure.State
+const (
+	departureStateDelayed   = "Delayed"
+	departureStateCancelled = "Cancelled"
+)
+
+// Delay returns the real-time delay of the departure, RealTime minus ScheduledTime.
+// A negative value means the vehicle is early. Returns 0 if no real-time data is available.
+func (d *Departure) Delay() time.Duration {
+	if d.RealTime.IsZero() || d.ScheduledTime.IsZero() {
+		return 0
+	}
+	return d.RealTime.Sub(d.ScheduledTime.Time)
+}
+
+// IsDelayed reports whether the departure runs at least one minute late according
+// to its real-time data, or the API marks it as delayed. Cancelled departures are
+// never delayed.
+func (d *Departure) IsDelayed() bool {
+	if d.IsCancelled() {
+		return false
+	}
+	return d.State == departureStateDelayed || d.Delay() >= time.Minute
+}
+
+// IsCancelled reports whether the departure was cancelled, see CancelReasons
+func (d *Departure) IsCancelled() bool {
+	return d.State == departureStateCancelled
+}
+
+// ExpectedTime returns the real-time departure time, or the scheduled time if no
+// real-time data is available
+func (d *Departure) ExpectedTime() time.Time {
+	if d.RealTime.IsZero() {
+		return d.ScheduledTime.Time
+	}
+	return d.RealTime.Time
+}
@@ -0,0 +1,135 @@
+package dvb
+
+import (
+	"sync"
+	"time"
+)
+
+// DepartureEventKind classifies the changes between two departure board snapshots
+type DepartureEventKind string
+
+const (
+	DepartureAdded  DepartureEventKind = "added"            // A departure appeared on the board
+	DelayChanged    DepartureEventKind = "delay-changed"    // The real-time delay changed
+	PlatformChanged DepartureEventKind = "platform-changed" // The departure moved to another platform
+	Cancelled       DepartureEventKind = "cancelled"        // The departure was cancelled
+	Departed        DepartureEventKind = "departed"         // The departure left the board after its departure time
+)
+
+// departedGrace is how long before its departure time a departure disappearing from
+// the board still counts as departed, as boards drop departures shortly before they leave
+const departedGrace = time.Minute
+
+// DepartureEvent is a single change between two departure board snapshots
+type DepartureEvent struct {
+	Kind DepartureEventKind
+
+	// Departure is the current state, or the last known state for Departed events
+	Departure Departure
+
+	// OldDelay and NewDelay are set for DelayChanged events
+	OldDelay time.Duration
+	NewDelay time.Duration
+
+	// OldPlatform and NewPlatform are set for PlatformChanged events
+	OldPlatform Platform
+	NewPlatform Platform
+}
+
+// DiffDepartures compares two consecutive snapshots of a departure board and returns
+// the changes in the order of the current board, followed by departed departures.
+// Departures are matched by Id. A departure missing from the current board counts
+// as departed if its departure time has passed at now; otherwise it is assumed to
+// have left the requested window and is ignored.
+func DiffDepartures(previous, current []Departure, now time.Time) []DepartureEvent {
+	before := make(map[string]Departure, len(previous))
+	for _, departure := range previous {
+		before[departure.Id] = departure
+	}
+
+	var events []DepartureEvent
+	seen := make(map[string]bool, len(current))
+	for _, departure := range current {
+		seen[departure.Id] = true
+
+		old, ok := before[departure.Id]
+		if !ok {
+			events = append(events, DepartureEvent{Kind: DepartureAdded, Departure: departure})
+			if departure.IsCancelled() {
+				events = append(events, DepartureEvent{Kind: Cancelled, Departure: departure})
+			}
+			continue
+		}
+
+		if departure.IsCancelled() && !old.IsCancelled() {
+			events = append(events, DepartureEvent{Kind: Cancelled, Departure: departure})
+		}
+		if oldDelay, newDelay := old.Delay(), departure.Delay(); oldDelay != newDelay {
+			events = append(events, DepartureEvent{
+				Kind:      DelayChanged,
+				Departure: departure,
+				OldDelay:  oldDelay,
+				NewDelay:  newDelay,
+			})
+		}
+		if old.Platform != departure.Platform {
+			events = append(events, DepartureEvent{
+				Kind:        PlatformChanged,
+				Departure:   departure,
+				OldPlatform: old.Platform,
+				NewPlatform: departure.Platform,
+			})
+		}
+	}
+
+	for _, departure := range previous {
+		if seen[departure.Id] {
+			continue
+		}
+		departureTime := firstDate(&departure.RealTime, &departure.ScheduledTime)
+		if !departureTime.IsZero() && !now.Before(departureTime.Add(-departedGrace)) {
+			events = append(events, DepartureEvent{Kind: Departed, Departure: departure})
+		}
+	}
+
+	return events
+}
+
+// DepartureDiffer remembers the previous snapshot of a departure board and emits
+// the changes for each new one. It is safe for concurrent use.
+//
+// Example usage:
+//
+//	differ := &dvb.DepartureDiffer{}
+//	for range ticker.C {
+//		response, err := client.MonitorStop(ctx, params)
+//		if err != nil {
+//			continue
+//		}
+//		for _, event := range differ.Update(response.Departures, time.Now()) {
+//			fmt.Println(event.Kind, event.Departure.LineName, event.Departure.Direction)
+//		}
+//	}
+type DepartureDiffer struct {
+	mu       sync.Mutex
+	previous []Departure
+	started  bool
+}
+
+// Update records the current snapshot and returns the changes since the previous one.
+// The first snapshot is only recorded and produces no events.
+func (d *DepartureDiffer) Update(current []Departure, now time.Time) []DepartureEvent {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	snapshot := append([]Departure(nil), current...)
+	if !d.started {
+		d.started = true
+		d.previous = snapshot
+		return nil
+	}
+
+	events := DiffDepartures(d.previous, snapshot, now)
+	d.previous = snapshot
+	return events
+}
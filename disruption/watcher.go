@@ -0,0 +1,347 @@
+// Package disruption watches the route changes of the network and posts JSON
+// payloads to webhooks when disruptions affecting selected lines or stops appear
+// or are resolved.
+//
+// Example usage:
+//
+//	watcher, err := disruption.New(disruption.Config{
+//		Client:   dvb.NewClient(dvb.Config{}),
+//		Lines:    []string{"3", "11"},
+//		Webhooks: []disruption.Webhook{{URL: "https://example.com/hooks/dvb", Secret: "s3cret"}},
+//	})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	if err := watcher.Start(ctx); err != nil {
+//		log.Fatal(err)
+//	}
+//	defer watcher.Stop()
+package disruption
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/niclaszll/dvb-go"
+)
+
+// EventKind classifies disruption events
+type EventKind string
+
+const (
+	Appeared EventKind = "disruption.appeared" // A matching disruption came into effect
+	Resolved EventKind = "disruption.resolved" // A disruption is no longer in effect or was removed
+)
+
+// Config configures the watcher
+type Config struct {
+	// Client queries the route changes and the lines of StopIds
+	Client dvb.API
+
+	// Lines restricts the disruptions to these line names (optional)
+	Lines []string
+
+	// StopIds restricts the disruptions to lines serving these stops (optional).
+	// If both Lines and StopIds are empty, all disruptions are reported.
+	StopIds []string
+
+	// Webhooks receive every event
+	Webhooks []Webhook
+
+	// OnEvent is called for every event, e.g. to forward it to a notify.Registry (optional)
+	OnEvent func(ctx context.Context, event Event)
+
+	// Interval is the time between two polls (optional, defaults to 5m)
+	Interval time.Duration
+
+	// IncludeUpcoming reports announced disruptions before their validity period
+	// begins. By default, disruptions appear when they come into effect.
+	IncludeUpcoming bool
+
+	// NotifyExisting reports the disruptions found by the first poll. By default,
+	// the first poll only records them, so restarts don't repeat old events.
+	NotifyExisting bool
+
+	// HTTPClient sends the webhook requests (optional, defaults to a client with a 10s timeout)
+	HTTPClient *http.Client
+
+	// Errors receives polling and delivery errors (optional)
+	Errors func(err error)
+}
+
+// Disruption is a route change affecting the watched lines or stops
+type Disruption struct {
+	Id          string `json:"id"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+
+	// Type is the route change type (e.g., "Scheduled", "ShortTerm")
+	Type string `json:"type"`
+
+	// Lines are the names of the affected lines
+	Lines []string `json:"lines"`
+
+	// StopIds are the watched stops served by the affected lines
+	StopIds []string `json:"stopIds,omitempty"`
+
+	// ValidFrom and ValidUntil are the bounds of the validity periods, if known
+	ValidFrom  *time.Time `json:"validFrom,omitempty"`
+	ValidUntil *time.Time `json:"validUntil,omitempty"`
+
+	PublishDate time.Time `json:"publishDate"`
+}
+
+// Event is the JSON payload posted to webhooks
+type Event struct {
+	Kind       EventKind  `json:"event"`
+	Disruption Disruption `json:"disruption"`
+	Time       time.Time  `json:"time"`
+}
+
+// Watcher polls the route changes and reports disruptions as they appear and are resolved
+type Watcher struct {
+	config Config
+
+	// updateMu serializes polls, as the known disruptions are compared between them
+	updateMu    sync.Mutex
+	known       map[string]Disruption
+	initialized bool
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// New creates a Watcher. Call Start to begin polling or Update to poll once.
+func New(config Config) (*Watcher, error) {
+	if config.Client == nil {
+		return nil, errors.New("client can not be nil")
+	}
+	for _, webhook := range config.Webhooks {
+		if webhook.URL == "" {
+			return nil, errors.New("webhook url can not be empty")
+		}
+	}
+	if config.Interval <= 0 {
+		config.Interval = 5 * time.Minute
+	}
+	if config.HTTPClient == nil {
+		config.HTTPClient = &http.Client{Timeout: 10 * time.Second}
+	}
+
+	return &Watcher{config: config, known: make(map[string]Disruption)}, nil
+}
+
+// Start begins polling in the background until Stop is called or ctx is cancelled.
+// A watcher can only be started once.
+func (w *Watcher) Start(ctx context.Context) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.done != nil {
+		return errors.New("watcher already started")
+	}
+
+	ctx, w.cancel = context.WithCancel(ctx)
+	w.done = make(chan struct{})
+	go w.run(ctx, w.done)
+	return nil
+}
+
+// Stop ends polling and waits for the background goroutine to exit
+func (w *Watcher) Stop() {
+	w.mu.Lock()
+	cancel, done := w.cancel, w.done
+	w.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+		<-done
+	}
+}
+
+func (w *Watcher) run(ctx context.Context, done chan struct{}) {
+	defer close(done)
+
+	ticker := time.NewTicker(w.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := w.Update(ctx); err != nil && ctx.Err() == nil && w.config.Errors != nil {
+			w.config.Errors(err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Disruptions returns the matching disruptions found by the latest poll
+func (w *Watcher) Disruptions() []Disruption {
+	w.updateMu.Lock()
+	defer w.updateMu.Unlock()
+
+	disruptions := make([]Disruption, 0, len(w.known))
+	for _, disruption := range w.known {
+		disruptions = append(disruptions, disruption)
+	}
+	sort.Slice(disruptions, func(i, j int) bool { return disruptions[i].Id < disruptions[j].Id })
+	return disruptions
+}
+
+// Update polls the route changes once and delivers the events for disruptions
+// that appeared or were resolved since the previous poll. Delivery errors are
+// reported to Config.Errors; the returned error only covers polling.
+func (w *Watcher) Update(ctx context.Context) error {
+	w.updateMu.Lock()
+	defer w.updateMu.Unlock()
+
+	current, err := w.poll(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	var events []Event
+	for id, disruption := range current {
+		if _, ok := w.known[id]; !ok {
+			events = append(events, Event{Kind: Appeared, Disruption: disruption, Time: now})
+		}
+	}
+	for id, disruption := range w.known {
+		if _, ok := current[id]; !ok {
+			events = append(events, Event{Kind: Resolved, Disruption: disruption, Time: now})
+		}
+	}
+	sort.Slice(events, func(i, j int) bool {
+		if events[i].Kind != events[j].Kind {
+			return events[i].Kind < events[j].Kind
+		}
+		return events[i].Disruption.Id < events[j].Disruption.Id
+	})
+
+	notify := w.initialized || w.config.NotifyExisting
+	w.known, w.initialized = current, true
+	if notify {
+		for _, event := range events {
+			w.deliver(ctx, event)
+		}
+	}
+	return nil
+}
+
+// poll returns the current disruptions matching the filter, keyed by Id
+func (w *Watcher) poll(ctx context.Context) (map[string]Disruption, error) {
+	response, err := w.config.Client.GetRouteChanges(ctx, &dvb.GetRouteChangesParams{ShortTerm: dvb.Bool(true)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get route changes: %w", err)
+	}
+
+	// Lines serving the watched stops, mapped to the stops they serve
+	stopsByLine := make(map[string][]string)
+	for _, stopId := range w.config.StopIds {
+		lines, err := w.config.Client.GetLines(ctx, &dvb.GetLinesParams{StopId: stopId})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get lines of stop %s: %w", stopId, err)
+		}
+		for _, line := range lines.Lines {
+			stopsByLine[line.Name] = append(stopsByLine[line.Name], stopId)
+		}
+	}
+
+	now := time.Now()
+	current := make(map[string]Disruption)
+	for _, change := range response.Changes {
+		if !w.config.IncludeUpcoming && !change.ActiveAt(now) {
+			continue
+		}
+
+		disruption := newDisruption(change)
+		matched := len(w.config.Lines) == 0 && len(w.config.StopIds) == 0
+		for _, line := range response.LinesOf(change.Id) {
+			disruption.Lines = append(disruption.Lines, line.Name)
+			if slices.Contains(w.config.Lines, line.Name) {
+				matched = true
+			}
+			if stopIds, ok := stopsByLine[line.Name]; ok {
+				matched = true
+				for _, stopId := range stopIds {
+					if !slices.Contains(disruption.StopIds, stopId) {
+						disruption.StopIds = append(disruption.StopIds, stopId)
+					}
+				}
+			}
+		}
+		if matched {
+			current[change.Id] = disruption
+		}
+	}
+	return current, nil
+}
+
+func newDisruption(change dvb.RouteChange) Disruption {
+	disruption := Disruption{
+		Id:          change.Id,
+		Title:       change.Title,
+		Description: change.Description,
+		Type:        change.Type,
+		Lines:       []string{},
+		PublishDate: change.PublishDate.Time,
+	}
+
+	var from, until time.Time
+	openEnded := false
+	for _, period := range change.ValidityPeriods {
+		if from.IsZero() || period.Begin.Before(from) {
+			from = period.Begin.Time
+		}
+		if period.End.IsZero() {
+			openEnded = true
+		} else if period.End.After(until) {
+			until = period.End.Time
+		}
+	}
+	if !from.IsZero() {
+		disruption.ValidFrom = &from
+	}
+	if !until.IsZero() && !openEnded {
+		disruption.ValidUntil = &until
+	}
+	return disruption
+}
+
+// deliver posts the event to all webhooks and calls OnEvent
+func (w *Watcher) deliver(ctx context.Context, event Event) {
+	if w.config.OnEvent != nil {
+		w.config.OnEvent(ctx, event)
+	}
+	if len(w.config.Webhooks) == 0 {
+		return
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		w.report(fmt.Errorf("failed to encode event: %w", err))
+		return
+	}
+	for _, webhook := range w.config.Webhooks {
+		if err := webhook.post(ctx, w.config.HTTPClient, event.Kind, payload); err != nil {
+			w.report(err)
+		}
+	}
+}
+
+func (w *Watcher) report(err error) {
+	if w.config.Errors != nil {
+		w.config.Errors(err)
+	}
+}
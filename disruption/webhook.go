@@ -0,0 +1,69 @@
+package disruption
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// SignatureHeader carries the HMAC-SHA256 signature of the payload if Webhook.Secret
+// is set, formatted as "sha256=<hex>"
+const SignatureHeader = "X-DVB-Signature"
+
+// EventHeader carries the EventKind of the payload
+const EventHeader = "X-DVB-Event"
+
+// Webhook is an endpoint receiving events as JSON POST requests
+type Webhook struct {
+	// URL is the endpoint to post to (required)
+	URL string
+
+	// Secret signs the payload, see SignatureHeader (optional)
+	Secret string
+
+	// Headers are added to every request, e.g. for authentication (optional)
+	Headers map[string]string
+}
+
+// Sign returns the value of SignatureHeader for payload. Receivers can recompute
+// it to verify that a request was sent by the watcher.
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// post sends the payload to the webhook. Any 2xx status counts as success.
+func (w Webhook) post(ctx context.Context, client *http.Client, kind EventKind, payload []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, string(kind))
+	if w.Secret != "" {
+		req.Header.Set(SignatureHeader, Sign(w.Secret, payload))
+	}
+	for name, value := range w.Headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("webhook %s: HTTP %d: %s", w.URL, resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
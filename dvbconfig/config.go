@@ -0,0 +1,520 @@
+// Package dvbconfig loads YAML or TOML configuration files for the dvb tooling
+// (daemon and CLI modes): client settings, server ports, stops to watch and
+// notification backends. Files are validated and missing values defaulted, so
+// the tools can be run without writing Go.
+//
+// Example configuration (YAML):
+//
+//	client:
+//	  timeout: 15s
+//	server:
+//	  addr: ":8080"
+//	  api: true
+//	  rate_limit: 5
+//	  allowed_origins: ["https://kiosk.example.com"]
+//	watch:
+//	  - stop: "33000028"
+//	    lines: ["3", "11"]
+//	    interval: 30s
+//	    notifiers: [phone]
+//	notifiers:
+//	  - name: phone
+//	    type: ntfy
+//	    topic: my-tram
+//	mqtt:
+//	  broker: tcp://localhost:1883
+//	disruptions:
+//	  lines: ["3", "11"]
+//	  webhooks:
+//	    - url: https://example.com/hooks/dvb
+//	      secret: s3cret
+package dvbconfig
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+
+	"github.com/niclaszll/dvb-go"
+	"github.com/niclaszll/dvb-go/disruption"
+	"github.com/niclaszll/dvb-go/dvbserver"
+	"github.com/niclaszll/dvb-go/mqtt"
+	"github.com/niclaszll/dvb-go/notify"
+	"github.com/niclaszll/dvb-go/notify/gotify"
+	"github.com/niclaszll/dvb-go/notify/ntfy"
+	"github.com/niclaszll/dvb-go/notify/pushover"
+)
+
+// Duration is a time.Duration written as string like "30s" or "5m" in config files
+type Duration time.Duration
+
+func (d *Duration) UnmarshalText(text []byte) error {
+	value, err := time.ParseDuration(string(text))
+	if err != nil {
+		return err
+	}
+	*d = Duration(value)
+	return nil
+}
+
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(time.Duration(d).String()), nil
+}
+
+// Config is the root of a configuration file
+type Config struct {
+	Client    Client     `yaml:"client" toml:"client"`
+	Server    Server     `yaml:"server" toml:"server"`
+	Watch     []Watch    `yaml:"watch" toml:"watch"`
+	Notifiers []Notifier `yaml:"notifiers" toml:"notifiers"`
+	MQTT      MQTT       `yaml:"mqtt" toml:"mqtt"`
+
+	Disruptions Disruptions `yaml:"disruptions" toml:"disruptions"`
+}
+
+// Client configures the dvb client
+type Client struct {
+	BaseURL     string            `yaml:"base_url" toml:"base_url"`
+	Mirrors     []string          `yaml:"mirrors" toml:"mirrors"`
+	UserAgent   string            `yaml:"user_agent" toml:"user_agent"`
+	Timeout     Duration          `yaml:"timeout" toml:"timeout"`
+	RateLimit   float64           `yaml:"rate_limit" toml:"rate_limit"`
+	APIKey      string            `yaml:"api_key" toml:"api_key"`
+	BearerToken string            `yaml:"bearer_token" toml:"bearer_token"`
+	Headers     map[string]string `yaml:"headers" toml:"headers"`
+
+	// CacheSize enables the response cache holding up to this many responses (0 disables it)
+	CacheSize int `yaml:"cache_size" toml:"cache_size"`
+
+	// CacheDir stores cached responses in this directory so they survive restarts,
+	// taking precedence over CacheSize (optional)
+	CacheDir string `yaml:"cache_dir" toml:"cache_dir"`
+
+	// StaleWhileRevalidate serves expired cached responses for up to this long while
+	// refreshing them in the background (optional, disabled if 0, requires CacheSize)
+	StaleWhileRevalidate Duration `yaml:"stale_while_revalidate" toml:"stale_while_revalidate"`
+
+	// RetryAttempts is the total number of attempts for transient failures (optional, no retries if 0)
+	RetryAttempts int `yaml:"retry_attempts" toml:"retry_attempts"`
+
+	// ProxyURL routes all requests through an HTTP or SOCKS5 proxy (optional)
+	ProxyURL string `yaml:"proxy_url" toml:"proxy_url"`
+
+	// MaxIdleConnsPerHost is the number of idle connections kept per host (optional, defaults to 32)
+	MaxIdleConnsPerHost int `yaml:"max_idle_conns_per_host" toml:"max_idle_conns_per_host"`
+
+	// IdleConnTimeout is how long an idle connection is kept open (optional, defaults to 90s)
+	IdleConnTimeout Duration `yaml:"idle_conn_timeout" toml:"idle_conn_timeout"`
+
+	// DialTimeout bounds establishing a connection (optional, defaults to 10s)
+	DialTimeout Duration `yaml:"dial_timeout" toml:"dial_timeout"`
+}
+
+// Server configures the server mode
+type Server struct {
+	Addr string `yaml:"addr" toml:"addr"`
+
+	// GRPCAddr enables the gRPC service (see package dvbgrpc) on this address (optional)
+	GRPCAddr string `yaml:"grpc_addr" toml:"grpc_addr"`
+
+	ProbeInterval Duration `yaml:"probe_interval" toml:"probe_interval"`
+
+	// Relay enables the caching relay speaking the upstream API's paths
+	Relay bool `yaml:"relay" toml:"relay"`
+
+	// API enables the REST endpoints under /api/
+	API bool `yaml:"api" toml:"api"`
+
+	// RateLimit is the maximum number of requests per second per client (optional, unlimited if 0)
+	RateLimit float64 `yaml:"rate_limit" toml:"rate_limit"`
+	RateBurst int     `yaml:"rate_burst" toml:"rate_burst"`
+
+	// TrustForwardedFor identifies clients by X-Forwarded-For, for use behind a reverse proxy
+	TrustForwardedFor bool `yaml:"trust_forwarded_for" toml:"trust_forwarded_for"`
+
+	// AllowedOrigins lists the origins allowed to call the server from browsers ("*" for any)
+	AllowedOrigins []string `yaml:"allowed_origins" toml:"allowed_origins"`
+}
+
+// Watch describes a stop to monitor
+type Watch struct {
+	Stop string `yaml:"stop" toml:"stop"`
+
+	// Lines restricts the watch to these lines (optional, all lines if empty)
+	Lines []string `yaml:"lines" toml:"lines"`
+
+	Interval Duration `yaml:"interval" toml:"interval"`
+
+	// MinDelay is the delay from which a departure triggers an alert (optional, defaults to 3m)
+	MinDelay Duration `yaml:"min_delay" toml:"min_delay"`
+
+	// Notifiers lists the names of notifiers receiving alerts for this stop
+	Notifiers []string `yaml:"notifiers" toml:"notifiers"`
+}
+
+// Notifier configures a notification backend
+type Notifier struct {
+	Name string `yaml:"name" toml:"name"`
+
+	// Type is one of "ntfy", "pushover" or "gotify"
+	Type string `yaml:"type" toml:"type"`
+
+	URL   string `yaml:"url" toml:"url"`
+	Topic string `yaml:"topic" toml:"topic"`
+	Token string `yaml:"token" toml:"token"`
+	User  string `yaml:"user" toml:"user"`
+
+	// MinInterval rate-limits notifications (optional, pushover and gotify only)
+	MinInterval Duration `yaml:"min_interval" toml:"min_interval"`
+}
+
+// MQTT configures publishing the watched stops to an MQTT broker (see package mqtt)
+type MQTT struct {
+	// Broker is the broker URL, e.g. "tcp://localhost:1883" (publishing is disabled if empty)
+	Broker   string `yaml:"broker" toml:"broker"`
+	ClientId string `yaml:"client_id" toml:"client_id"`
+	Username string `yaml:"username" toml:"username"`
+	Password string `yaml:"password" toml:"password"`
+
+	// TopicPrefix is prepended to all topics (optional, defaults to "dvb")
+	TopicPrefix string `yaml:"topic_prefix" toml:"topic_prefix"`
+
+	QoS      int      `yaml:"qos" toml:"qos"`
+	Interval Duration `yaml:"interval" toml:"interval"`
+}
+
+// Disruptions configures posting disruptions of lines or stops to webhooks (see package disruption)
+type Disruptions struct {
+	// Lines and Stops filter the disruptions (optional, all disruptions are posted if both are empty)
+	Lines []string `yaml:"lines" toml:"lines"`
+	Stops []string `yaml:"stops" toml:"stops"`
+
+	// Webhooks receive the disruptions (watching is disabled if empty)
+	Webhooks []Webhook `yaml:"webhooks" toml:"webhooks"`
+
+	// Interval is the time between two polls (optional, defaults to 5m)
+	Interval Duration `yaml:"interval" toml:"interval"`
+
+	// Upcoming posts announced disruptions before they come into effect (optional)
+	Upcoming bool `yaml:"upcoming" toml:"upcoming"`
+}
+
+// Webhook is an endpoint receiving disruption events
+type Webhook struct {
+	URL string `yaml:"url" toml:"url"`
+
+	// Secret signs the payloads with HMAC-SHA256 (optional)
+	Secret  string            `yaml:"secret" toml:"secret"`
+	Headers map[string]string `yaml:"headers" toml:"headers"`
+}
+
+// Load reads the configuration file at path. The format is chosen by extension
+// (.yaml, .yml or .toml). Defaults are applied and the result is validated.
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config: %w", err)
+	}
+
+	var config Config
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		// An empty document is a valid, empty configuration
+		if err := decoder.Decode(&config); err != nil && !errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("failed to parse config: %w", err)
+		}
+	case ".toml":
+		metadata, err := toml.Decode(string(data), &config)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse config: %w", err)
+		}
+		if undecoded := metadata.Undecoded(); len(undecoded) > 0 {
+			return nil, fmt.Errorf("failed to parse config: unknown key %s", undecoded[0])
+		}
+	default:
+		return nil, fmt.Errorf("unsupported config format %q", filepath.Ext(path))
+	}
+
+	if err := config.ApplyEnv(); err != nil {
+		return nil, err
+	}
+	config.applyDefaults()
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+// EnvAddr overrides the server listen address
+const EnvAddr = "DVB_ADDR"
+
+// ApplyEnv overrides configured values with the DVB_* environment variables
+// (see dvb.Config.WithEnv and EnvAddr). Precedence is: command-line flags,
+// then environment variables, then the configuration file, then defaults.
+func (c *Config) ApplyEnv() error {
+	client, err := c.Client.DVBConfig().WithEnv()
+	if err != nil {
+		return err
+	}
+	c.Client.BaseURL = client.BaseURL
+	c.Client.Mirrors = client.Mirrors
+	c.Client.Timeout = Duration(client.Timeout)
+	c.Client.UserAgent = client.UserAgent
+	c.Client.RateLimit = client.RateLimit
+	c.Client.APIKey = client.APIKey
+	c.Client.BearerToken = client.BearerToken
+
+	if addr := os.Getenv(EnvAddr); addr != "" {
+		c.Server.Addr = addr
+	}
+	return nil
+}
+
+func (c *Config) applyDefaults() {
+	if c.Server.Addr == "" {
+		c.Server.Addr = ":8080"
+	}
+	for i := range c.Watch {
+		if c.Watch[i].Interval == 0 {
+			c.Watch[i].Interval = Duration(60 * time.Second)
+		}
+		if c.Watch[i].MinDelay == 0 {
+			c.Watch[i].MinDelay = Duration(3 * time.Minute)
+		}
+	}
+}
+
+// Validate checks the configuration for missing or inconsistent values
+func (c *Config) Validate() error {
+	var errs []error
+
+	names := make(map[string]bool)
+	for i, n := range c.Notifiers {
+		if n.Name == "" {
+			errs = append(errs, fmt.Errorf("notifiers[%d]: name can not be empty", i))
+		} else if names[n.Name] {
+			errs = append(errs, fmt.Errorf("notifiers[%d]: duplicate name %q", i, n.Name))
+		}
+		names[n.Name] = true
+
+		switch n.Type {
+		case "ntfy":
+			if n.Topic == "" {
+				errs = append(errs, fmt.Errorf("notifier %s: topic can not be empty", n.Name))
+			}
+		case "pushover":
+			if n.Token == "" || n.User == "" {
+				errs = append(errs, fmt.Errorf("notifier %s: token and user can not be empty", n.Name))
+			}
+		case "gotify":
+			if n.URL == "" || n.Token == "" {
+				errs = append(errs, fmt.Errorf("notifier %s: url and token can not be empty", n.Name))
+			}
+		default:
+			errs = append(errs, fmt.Errorf("notifier %s: unknown type %q", n.Name, n.Type))
+		}
+	}
+
+	for i, w := range c.Watch {
+		if w.Stop == "" {
+			errs = append(errs, fmt.Errorf("watch[%d]: stop can not be empty", i))
+		}
+		if w.Interval < Duration(time.Second) {
+			errs = append(errs, fmt.Errorf("watch[%d]: interval must be at least 1s", i))
+		}
+		for _, name := range w.Notifiers {
+			if !names[name] {
+				errs = append(errs, fmt.Errorf("watch[%d]: unknown notifier %q", i, name))
+			}
+		}
+	}
+
+	if c.Client.RetryAttempts < 0 {
+		errs = append(errs, errors.New("client: retry_attempts can not be negative"))
+	}
+	if c.Client.Timeout < 0 {
+		errs = append(errs, errors.New("client: timeout can not be negative"))
+	}
+	if c.Client.ProxyURL != "" {
+		if u, err := url.Parse(c.Client.ProxyURL); err != nil || u.Scheme == "" || u.Host == "" {
+			errs = append(errs, fmt.Errorf("client: invalid proxy_url %q", c.Client.ProxyURL))
+		}
+	}
+	if c.Client.StaleWhileRevalidate < 0 {
+		errs = append(errs, errors.New("client: stale_while_revalidate can not be negative"))
+	}
+	if c.Client.MaxIdleConnsPerHost < 0 || c.Client.IdleConnTimeout < 0 || c.Client.DialTimeout < 0 {
+		errs = append(errs, errors.New("client: transport settings can not be negative"))
+	}
+	if c.MQTT.QoS < 0 || c.MQTT.QoS > 2 {
+		errs = append(errs, errors.New("mqtt: qos must be 0, 1 or 2"))
+	}
+	if c.MQTT.Broker != "" && len(c.Watch) == 0 {
+		errs = append(errs, errors.New("mqtt: watch can not be empty"))
+	}
+	for i, w := range c.Disruptions.Webhooks {
+		if w.URL == "" {
+			errs = append(errs, fmt.Errorf("disruptions.webhooks[%d]: url can not be empty", i))
+		}
+	}
+	if c.Server.RateLimit < 0 {
+		errs = append(errs, errors.New("server: rate_limit can not be negative"))
+	}
+
+	return errors.Join(errs...)
+}
+
+// DVBConfig converts the client section into a dvb.Config
+func (c Client) DVBConfig() dvb.Config {
+	var cache dvb.Cache
+	if c.CacheDir != "" {
+		cache = dvb.NewFileCache(c.CacheDir)
+	} else if c.CacheSize > 0 {
+		cache = dvb.NewMemoryCache(c.CacheSize)
+	}
+
+	// Validate rejects invalid proxy URLs, so parse errors are not expected here
+	var proxyURL *url.URL
+	if c.ProxyURL != "" {
+		proxyURL, _ = url.Parse(c.ProxyURL)
+	}
+
+	return dvb.Config{
+		BaseURL:              c.BaseURL,
+		Mirrors:              c.Mirrors,
+		UserAgent:            c.UserAgent,
+		Timeout:              time.Duration(c.Timeout),
+		RateLimit:            c.RateLimit,
+		APIKey:               c.APIKey,
+		BearerToken:          c.BearerToken,
+		Headers:              c.Headers,
+		Cache:                cache,
+		StaleWhileRevalidate: time.Duration(c.StaleWhileRevalidate),
+		RetryPolicy:          dvb.RetryPolicy{MaxAttempts: c.RetryAttempts, Jitter: 0.2},
+		Transport: dvb.TransportOptions{
+			MaxIdleConnsPerHost: c.MaxIdleConnsPerHost,
+			IdleConnTimeout:     time.Duration(c.IdleConnTimeout),
+			DialTimeout:         time.Duration(c.DialTimeout),
+			ProxyURL:            proxyURL,
+		},
+	}
+}
+
+// Options converts the server section into dvbserver.Options
+func (s Server) Options() dvbserver.Options {
+	return dvbserver.Options{
+		ProbeInterval:     time.Duration(s.ProbeInterval),
+		Relay:             s.Relay,
+		API:               s.API,
+		RateLimit:         s.RateLimit,
+		RateBurst:         s.RateBurst,
+		TrustForwardedFor: s.TrustForwardedFor,
+		AllowedOrigins:    s.AllowedOrigins,
+	}
+}
+
+// Publisher creates the MQTT publisher of the watched stops, or returns nil if
+// no broker is configured
+func (c *Config) Publisher(client dvb.API) (*mqtt.Publisher, error) {
+	if c.MQTT.Broker == "" {
+		return nil, nil
+	}
+
+	stops := make([]mqtt.Stop, len(c.Watch))
+	for i, w := range c.Watch {
+		stops[i] = mqtt.Stop{StopId: w.Stop, Lines: w.Lines}
+	}
+	return mqtt.New(mqtt.Config{
+		Client:      client,
+		Stops:       stops,
+		Broker:      c.MQTT.Broker,
+		ClientId:    c.MQTT.ClientId,
+		Username:    c.MQTT.Username,
+		Password:    c.MQTT.Password,
+		TopicPrefix: c.MQTT.TopicPrefix,
+		QoS:         byte(c.MQTT.QoS),
+		Interval:    time.Duration(c.MQTT.Interval),
+	})
+}
+
+// DisruptionWatcher creates the watcher posting disruptions to the configured
+// webhooks, or returns nil if no webhook is configured
+func (c *Config) DisruptionWatcher(client dvb.API, errs func(error)) (*disruption.Watcher, error) {
+	if len(c.Disruptions.Webhooks) == 0 {
+		return nil, nil
+	}
+
+	webhooks := make([]disruption.Webhook, len(c.Disruptions.Webhooks))
+	for i, w := range c.Disruptions.Webhooks {
+		webhooks[i] = disruption.Webhook{URL: w.URL, Secret: w.Secret, Headers: w.Headers}
+	}
+	return disruption.New(disruption.Config{
+		Client:          client,
+		Lines:           c.Disruptions.Lines,
+		StopIds:         c.Disruptions.Stops,
+		Webhooks:        webhooks,
+		Interval:        time.Duration(c.Disruptions.Interval),
+		IncludeUpcoming: c.Disruptions.Upcoming,
+		Errors:          errs,
+	})
+}
+
+// Build creates the notification backend described by the notifier section
+func (n Notifier) Build() (notify.Notifier, error) {
+	switch n.Type {
+	case "ntfy":
+		return ntfy.New(ntfy.Config{ServerURL: n.URL, Topic: n.Topic, Token: n.Token}), nil
+	case "pushover":
+		return pushover.New(pushover.Config{
+			APIURL:      n.URL,
+			Token:       n.Token,
+			User:        n.User,
+			MinInterval: time.Duration(n.MinInterval),
+		}), nil
+	case "gotify":
+		return gotify.New(gotify.Config{
+			ServerURL:   n.URL,
+			Token:       n.Token,
+			MinInterval: time.Duration(n.MinInterval),
+		}), nil
+	default:
+		return nil, fmt.Errorf("unknown notifier type %q", n.Type)
+	}
+}
+
+// Registry creates a notify.Registry with all configured notifiers and one
+// subscription per watched stop
+func (c *Config) Registry() (*notify.Registry, error) {
+	registry := notify.NewRegistry()
+	for _, n := range c.Notifiers {
+		notifier, err := n.Build()
+		if err != nil {
+			return nil, err
+		}
+		registry.RegisterNotifier(n.Name, notifier)
+	}
+
+	for i, w := range c.Watch {
+		err := registry.Subscribe(notify.Subscription{
+			Id:          fmt.Sprintf("watch-%d", i),
+			Rule:        notify.Rule{StopIds: []string{w.Stop}, Lines: w.Lines},
+			Notifiers:   w.Notifiers,
+			DedupWindow: 30 * time.Minute,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return registry, nil
+}
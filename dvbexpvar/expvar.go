@@ -0,0 +1,87 @@
+// Package dvbexpvar exposes client internals (request counts, latencies, cache
+// hits, retries and poller state) via the standard library's expvar package,
+// providing basic introspection at /debug/vars without further dependencies.
+//
+// Example usage:
+//
+//	client := dvb.NewClient(dvb.Config{
+//		Metrics: dvbexpvar.New("dvb"),
+//	})
+package dvbexpvar
+
+import (
+	"expvar"
+	"strconv"
+	"time"
+
+	"github.com/niclaszll/dvb-go"
+)
+
+// Recorder implements dvb.MetricsRecorder by publishing expvar maps
+type Recorder struct {
+	requests     *expvar.Map
+	errors       *expvar.Map
+	statusCodes  *expvar.Map
+	latencyMs    *expvar.Map
+	cacheHits    *expvar.Map
+	cacheMisses  *expvar.Map
+	retries      *expvar.Map
+	pollers      *expvar.Map
+	pollersCount *expvar.Int
+}
+
+var _ dvb.MetricsRecorder = (*Recorder)(nil)
+
+// New creates a Recorder publishing its variables under the given prefix
+// (e.g. "dvb" results in "dvb.requests", "dvb.retries", ...). Like expvar.Publish,
+// it panics if a variable with the same name is already published.
+func New(prefix string) *Recorder {
+	if prefix != "" {
+		prefix += "."
+	}
+	return &Recorder{
+		requests:     expvar.NewMap(prefix + "requests"),
+		errors:       expvar.NewMap(prefix + "errors"),
+		statusCodes:  expvar.NewMap(prefix + "status_codes"),
+		latencyMs:    expvar.NewMap(prefix + "latency_ms_total"),
+		cacheHits:    expvar.NewMap(prefix + "cache_hits"),
+		cacheMisses:  expvar.NewMap(prefix + "cache_misses"),
+		retries:      expvar.NewMap(prefix + "retries"),
+		pollers:      expvar.NewMap(prefix + "pollers"),
+		pollersCount: expvar.NewInt(prefix + "pollers_running"),
+	}
+}
+
+func (r *Recorder) RequestDone(endpoint string, statusCode int, duration time.Duration, err error) {
+	r.requests.Add(endpoint, 1)
+	r.latencyMs.AddFloat(endpoint, float64(duration)/float64(time.Millisecond))
+	if err != nil {
+		r.errors.Add(endpoint, 1)
+		return
+	}
+	r.statusCodes.Add(strconv.Itoa(statusCode), 1)
+}
+
+func (r *Recorder) CacheLookup(endpoint string, hit bool) {
+	if hit {
+		r.cacheHits.Add(endpoint, 1)
+	} else {
+		r.cacheMisses.Add(endpoint, 1)
+	}
+}
+
+func (r *Recorder) Retry(endpoint string) {
+	r.retries.Add(endpoint, 1)
+}
+
+func (r *Recorder) PollerState(name string, running bool) {
+	state := new(expvar.String)
+	if running {
+		state.Set("running")
+		r.pollersCount.Add(1)
+	} else {
+		state.Set("stopped")
+		r.pollersCount.Add(-1)
+	}
+	r.pollers.Set(name, state)
+}
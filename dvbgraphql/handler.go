@@ -0,0 +1,68 @@
+package dvbgraphql
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/graphql-go/graphql"
+)
+
+// maxRequestBody limits the size of GraphQL request bodies
+const maxRequestBody = 1 << 20
+
+// Request is a GraphQL request as sent by clients over HTTP
+type Request struct {
+	Query         string         `json:"query"`
+	Variables     map[string]any `json:"variables"`
+	OperationName string         `json:"operationName"`
+}
+
+// Handler serves GraphQL requests against schema. Queries are accepted as JSON
+// body of POST requests or as query parameters (query, variables, operationName)
+// of GET requests.
+func Handler(schema graphql.Schema) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var request Request
+		switch req.Method {
+		case http.MethodGet:
+			request.Query = req.URL.Query().Get("query")
+			request.OperationName = req.URL.Query().Get("operationName")
+			if variables := req.URL.Query().Get("variables"); variables != "" {
+				if err := json.Unmarshal([]byte(variables), &request.Variables); err != nil {
+					http.Error(w, "invalid variables", http.StatusBadRequest)
+					return
+				}
+			}
+		case http.MethodPost:
+			body, err := io.ReadAll(io.LimitReader(req.Body, maxRequestBody))
+			if err != nil {
+				http.Error(w, "failed to read request body", http.StatusBadRequest)
+				return
+			}
+			if err := json.Unmarshal(body, &request); err != nil {
+				http.Error(w, "invalid request body", http.StatusBadRequest)
+				return
+			}
+		default:
+			w.Header().Set("Allow", "GET, POST")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		if request.Query == "" {
+			http.Error(w, "query can not be empty", http.StatusBadRequest)
+			return
+		}
+
+		result := graphql.Do(graphql.Params{
+			Schema:         schema,
+			RequestString:  request.Query,
+			VariableValues: request.Variables,
+			OperationName:  request.OperationName,
+			Context:        req.Context(),
+		})
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(result)
+	})
+}
@@ -0,0 +1,267 @@
+package dvbgraphql
+
+import (
+	"errors"
+	"time"
+
+	"github.com/graphql-go/graphql"
+
+	"github.com/niclaszll/dvb-go"
+)
+
+// departure is a departure together with the stop it departs from, which is
+// needed to resolve its trip
+type departure struct {
+	dvb.Departure
+	stopId string
+}
+
+// resolvers holds the types and resolvers that query the API
+type resolvers struct {
+	client dvb.API
+}
+
+// NewSchema creates the GraphQL schema resolving queries with client
+func NewSchema(client dvb.API) (graphql.Schema, error) {
+	if client == nil {
+		return graphql.Schema{}, errors.New("client can not be nil")
+	}
+	r := &resolvers{client: client}
+
+	departureType := r.departureType()
+	stopType := r.stopType(departureType)
+
+	query := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"stop": &graphql.Field{
+				Type:        stopType,
+				Description: "The stop with the given ID, or null if it is unknown",
+				Args: graphql.FieldConfigArgument{
+					"id": &graphql.ArgumentConfig{Type: nonNullString},
+				},
+				Resolve: r.resolveStop,
+			},
+			"stops": &graphql.Field{
+				Type:        graphql.NewList(graphql.NewNonNull(stopType)),
+				Description: "Stops matching a search term",
+				Args: graphql.FieldConfigArgument{
+					"query": &graphql.ArgumentConfig{Type: nonNullString},
+					"limit": &graphql.ArgumentConfig{Type: graphql.Int},
+				},
+				Resolve: r.resolveStops,
+			},
+			"departures": &graphql.Field{
+				Type:        graphql.NewList(graphql.NewNonNull(departureType)),
+				Description: "Upcoming departures at a stop",
+				Args: departureArgs(graphql.FieldConfigArgument{
+					"stopId": &graphql.ArgumentConfig{Type: nonNullString},
+				}),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return r.departures(p, p.Args["stopId"].(string))
+				},
+			},
+			"lines": &graphql.Field{
+				Type:        graphql.NewList(graphql.NewNonNull(lineType)),
+				Description: "Lines serving a stop",
+				Args: graphql.FieldConfigArgument{
+					"stopId": &graphql.ArgumentConfig{Type: nonNullString},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return r.lines(p, p.Args["stopId"].(string))
+				},
+			},
+			"route": &graphql.Field{
+				Type:        graphql.NewList(graphql.NewNonNull(routeType)),
+				Description: "Connections between two stops",
+				Args: graphql.FieldConfigArgument{
+					"from":    &graphql.ArgumentConfig{Type: nonNullString},
+					"to":      &graphql.ArgumentConfig{Type: nonNullString},
+					"time":    &graphql.ArgumentConfig{Type: graphql.DateTime, Description: "Departure time, or arrival time if arrival is true (defaults to now)"},
+					"arrival": &graphql.ArgumentConfig{Type: graphql.Boolean},
+				},
+				Resolve: r.resolveRoute,
+			},
+			"trip": &graphql.Field{
+				Type:        graphql.NewList(graphql.NewNonNull(tripStopType)),
+				Description: "All stops of a trip, see Departure.trip",
+				Args: graphql.FieldConfigArgument{
+					"id":     &graphql.ArgumentConfig{Type: nonNullString},
+					"stopId": &graphql.ArgumentConfig{Type: nonNullString},
+					"time":   &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.DateTime), Description: "Scheduled departure time at stopId"},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					at, _ := p.Args["time"].(time.Time)
+					return r.trip(p, p.Args["id"].(string), p.Args["stopId"].(string), at)
+				},
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{Query: query})
+}
+
+// departureArgs adds the arguments filtering departures to args
+func departureArgs(args graphql.FieldConfigArgument) graphql.FieldConfigArgument {
+	args["limit"] = &graphql.ArgumentConfig{Type: graphql.Int}
+	args["time"] = &graphql.ArgumentConfig{Type: graphql.DateTime, Description: "Start of the departures (defaults to now)"}
+	args["arrival"] = &graphql.ArgumentConfig{Type: graphql.Boolean, Description: "List arrivals instead of departures"}
+	return args
+}
+
+func (r *resolvers) stopType(departureType *graphql.Object) *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Stop",
+		Description: "A public transport stop",
+		Fields: graphql.Fields{
+			"id":        &graphql.Field{Type: nonNullString, Resolve: resolver(func(p dvb.Point) any { return p.Id })},
+			"name":      &graphql.Field{Type: nonNullString, Resolve: resolver(func(p dvb.Point) any { return p.Name })},
+			"place":     &graphql.Field{Type: graphql.String, Resolve: resolver(func(p dvb.Point) any { return p.Place })},
+			"latitude":  &graphql.Field{Type: graphql.Float, Resolve: resolver(func(p dvb.Point) any { return latitude(p.WGS84()) })},
+			"longitude": &graphql.Field{Type: graphql.Float, Resolve: resolver(func(p dvb.Point) any { return longitude(p.WGS84()) })},
+			"departures": &graphql.Field{
+				Type: graphql.NewList(graphql.NewNonNull(departureType)),
+				Args: departureArgs(graphql.FieldConfigArgument{}),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return r.departures(p, p.Source.(dvb.Point).Id)
+				},
+			},
+			"lines": &graphql.Field{
+				Type: graphql.NewList(graphql.NewNonNull(lineType)),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return r.lines(p, p.Source.(dvb.Point).Id)
+				},
+			},
+		},
+	})
+}
+
+func (r *resolvers) departureType() *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Departure",
+		Description: "A departure or arrival at a stop",
+		Fields: graphql.Fields{
+			"id":            &graphql.Field{Type: nonNullString, Resolve: resolver(func(d departure) any { return d.Id })},
+			"line":          &graphql.Field{Type: nonNullString, Resolve: resolver(func(d departure) any { return d.LineName })},
+			"direction":     &graphql.Field{Type: graphql.String, Resolve: resolver(func(d departure) any { return d.Direction })},
+			"mot":           &graphql.Field{Type: graphql.String, Resolve: resolver(func(d departure) any { return d.Mot.String() })},
+			"platform":      &graphql.Field{Type: platformType, Resolve: resolver(func(d departure) any { return d.Platform })},
+			"scheduledTime": &graphql.Field{Type: graphql.DateTime, Resolve: resolver(func(d departure) any { return timeValue(d.ScheduledTime.Time) })},
+			"realTime":      &graphql.Field{Type: graphql.DateTime, Resolve: resolver(func(d departure) any { return timeValue(d.RealTime.Time) })},
+			"delay": &graphql.Field{
+				Type:        graphql.Int,
+				Description: "Delay in minutes, or null without real-time data",
+				Resolve: resolver(func(d departure) any {
+					if d.RealTime.IsZero() || d.ScheduledTime.IsZero() {
+						return nil
+					}
+					return int(d.Delay().Minutes())
+				}),
+			},
+			"state":         &graphql.Field{Type: graphql.String, Resolve: resolver(func(d departure) any { return d.State })},
+			"occupancy":     &graphql.Field{Type: graphql.String, Resolve: resolver(func(d departure) any { return d.Occupancy.String() })},
+			"cancelReasons": &graphql.Field{Type: graphql.NewList(nonNullString), Resolve: resolver(func(d departure) any { return d.CancelReasons })},
+			"routeChanges":  &graphql.Field{Type: graphql.NewList(nonNullString), Resolve: resolver(func(d departure) any { return d.RouteChanges })},
+			"trip": &graphql.Field{
+				Type:        graphql.NewList(graphql.NewNonNull(tripStopType)),
+				Description: "All stops of the trip",
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					d := p.Source.(departure)
+					return r.trip(p, d.Id, d.stopId, d.ScheduledTime.Time)
+				},
+			},
+		},
+	})
+}
+
+func (r *resolvers) resolveStop(p graphql.ResolveParams) (any, error) {
+	id := p.Args["id"].(string)
+	response, err := r.client.GetPoint(p.Context, &dvb.GetPointParams{Query: id, StopsOnly: dvb.Bool(true)})
+	var notFound *dvb.NotFoundError
+	if errors.As(err, &notFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, point := range response.Points.OnlyStops() {
+		if point.Id == id {
+			return point, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *resolvers) resolveStops(p graphql.ResolveParams) (any, error) {
+	params := &dvb.GetPointParams{Query: p.Args["query"].(string), StopsOnly: dvb.Bool(true)}
+	if limit, ok := p.Args["limit"].(int); ok {
+		params.Limit = &limit
+	}
+	response, err := r.client.GetPoint(p.Context, params)
+	if err != nil {
+		return ignoreNotFound([]dvb.Point(nil), err)
+	}
+	return []dvb.Point(response.Points.OnlyStops()), nil
+}
+
+func (r *resolvers) resolveRoute(p graphql.ResolveParams) (any, error) {
+	params := &dvb.GetRouteParams{
+		Origin:           p.Args["from"].(string),
+		Destination:      p.Args["to"].(string),
+		ShortTermChanges: dvb.Bool(true),
+	}
+	if at, ok := p.Args["time"].(time.Time); ok {
+		params.Time = dvb.TimePtr(at)
+	}
+	if arrival, ok := p.Args["arrival"].(bool); ok {
+		params.IsArrivalTime = &arrival
+	}
+	response, err := r.client.GetRoute(p.Context, params)
+	if err != nil {
+		return ignoreNotFound([]dvb.Route(nil), err)
+	}
+	return response.Routes, nil
+}
+
+func (r *resolvers) departures(p graphql.ResolveParams, stopId string) (any, error) {
+	params := &dvb.MonitorStopParams{StopId: stopId, ShortTermChanges: dvb.Bool(true)}
+	if limit, ok := p.Args["limit"].(int); ok {
+		params.Limit = &limit
+	}
+	if at, ok := p.Args["time"].(time.Time); ok {
+		params.Time = dvb.TimePtr(at)
+	}
+	if arrival, ok := p.Args["arrival"].(bool); ok {
+		params.IsArrival = &arrival
+	}
+	response, err := r.client.MonitorStop(p.Context, params)
+	if err != nil {
+		return ignoreNotFound([]departure(nil), err)
+	}
+
+	departures := make([]departure, len(response.Departures))
+	for i, d := range response.Departures {
+		departures[i] = departure{Departure: d, stopId: stopId}
+	}
+	return departures, nil
+}
+
+func (r *resolvers) lines(p graphql.ResolveParams, stopId string) (any, error) {
+	response, err := r.client.GetLines(p.Context, &dvb.GetLinesParams{StopId: stopId})
+	if err != nil {
+		return ignoreNotFound([]dvb.Line(nil), err)
+	}
+	return response.Lines, nil
+}
+
+func (r *resolvers) trip(p graphql.ResolveParams, tripId, stopId string, at time.Time) (any, error) {
+	response, err := r.client.GetTripDetails(p.Context, &dvb.GetTripDetailsParams{
+		TripId: tripId,
+		StopId: stopId,
+		Time:   at,
+	})
+	if err != nil {
+		return ignoreNotFound([]dvb.TripStop(nil), err)
+	}
+	return response.Stops, nil
+}
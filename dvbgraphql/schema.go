@@ -0,0 +1,177 @@
+// Package dvbgraphql provides a GraphQL schema and resolvers over the dvb client,
+// so web frontends can query exactly the fields they need through one endpoint.
+//
+// The schema offers the queries stop, stops, departures, lines, route and trip.
+// Nested fields like Stop.departures or Departure.trip are only requested from
+// the API if a query selects them.
+//
+// Example usage:
+//
+//	schema, err := dvbgraphql.NewSchema(dvb.NewClient(dvb.Config{}))
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	http.Handle("/graphql", dvbgraphql.Handler(schema))
+//
+// Example query:
+//
+//	{
+//	  stop(id: "33000028") {
+//	    name
+//	    departures(limit: 5) { line direction realTime delay }
+//	  }
+//	}
+package dvbgraphql
+
+import (
+	"errors"
+	"time"
+
+	"github.com/graphql-go/graphql"
+
+	"github.com/niclaszll/dvb-go"
+)
+
+// resolver resolves the fields of a source value of type T
+func resolver[T any](fn func(source T) any) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (any, error) {
+		source, ok := p.Source.(T)
+		if !ok {
+			return nil, nil
+		}
+		return fn(source), nil
+	}
+}
+
+// timeValue returns t for a DateTime field, or nil if it is unknown
+func timeValue(t time.Time) any {
+	if t.IsZero() {
+		return nil
+	}
+	return t
+}
+
+// optionalTime returns the time of t for a DateTime field, or nil if it is unknown
+func optionalTime(t *dvb.Time) any {
+	if t == nil {
+		return nil
+	}
+	return timeValue(t.Time)
+}
+
+// latitude and longitude return the WGS84 coordinates of GK4 coordinates, or nil if they are unknown
+func latitude(lat, _ float64, ok bool) any {
+	if !ok {
+		return nil
+	}
+	return lat
+}
+
+func longitude(_, lon float64, ok bool) any {
+	if !ok {
+		return nil
+	}
+	return lon
+}
+
+var nonNullString = graphql.NewNonNull(graphql.String)
+
+var platformType = graphql.NewObject(graphql.ObjectConfig{
+	Name:        "Platform",
+	Description: "A platform or stop position",
+	Fields: graphql.Fields{
+		"name": &graphql.Field{Type: graphql.String, Resolve: resolver(func(p dvb.Platform) any { return p.Name })},
+		"type": &graphql.Field{Type: graphql.String, Resolve: resolver(func(p dvb.Platform) any { return p.Type })},
+	},
+})
+
+var tripStopType = graphql.NewObject(graphql.ObjectConfig{
+	Name:        "TripStop",
+	Description: "A stop of a single trip",
+	Fields: graphql.Fields{
+		"id":            &graphql.Field{Type: nonNullString, Resolve: resolver(func(s dvb.TripStop) any { return s.Id })},
+		"name":          &graphql.Field{Type: nonNullString, Resolve: resolver(func(s dvb.TripStop) any { return s.Name })},
+		"place":         &graphql.Field{Type: graphql.String, Resolve: resolver(func(s dvb.TripStop) any { return s.Place })},
+		"position":      &graphql.Field{Type: graphql.String, Description: "Previous, Current or Next relative to the stop the trip was picked at", Resolve: resolver(func(s dvb.TripStop) any { return s.Position })},
+		"platform":      &graphql.Field{Type: platformType, Resolve: resolver(func(s dvb.TripStop) any { return s.Platform })},
+		"scheduledTime": &graphql.Field{Type: graphql.DateTime, Resolve: resolver(func(s dvb.TripStop) any { return timeValue(s.ScheduledTime()) })},
+		"realTime":      &graphql.Field{Type: graphql.DateTime, Resolve: resolver(func(s dvb.TripStop) any { return optionalTime(s.RealTime) })},
+		"state":         &graphql.Field{Type: graphql.String, Resolve: resolver(func(s dvb.TripStop) any { return s.State })},
+		"occupancy":     &graphql.Field{Type: graphql.String, Resolve: resolver(func(s dvb.TripStop) any { return s.Occupancy.String() })},
+		"latitude":      &graphql.Field{Type: graphql.Float, Resolve: resolver(func(s dvb.TripStop) any { return latitude(s.WGS84()) })},
+		"longitude":     &graphql.Field{Type: graphql.Float, Resolve: resolver(func(s dvb.TripStop) any { return longitude(s.WGS84()) })},
+	},
+})
+
+var lineType = graphql.NewObject(graphql.ObjectConfig{
+	Name:        "Line",
+	Description: "A line serving a stop",
+	Fields: graphql.Fields{
+		"name": &graphql.Field{Type: nonNullString, Resolve: resolver(func(l dvb.Line) any { return l.Name })},
+		"mot":  &graphql.Field{Type: graphql.String, Resolve: resolver(func(l dvb.Line) any { return l.Mot.String() })},
+		"directions": &graphql.Field{
+			Type: graphql.NewList(nonNullString),
+			Resolve: resolver(func(l dvb.Line) any {
+				directions := make([]string, len(l.Directions))
+				for i, direction := range l.Directions {
+					directions[i] = direction.Name
+				}
+				return directions
+			}),
+		},
+	},
+})
+
+var routeStopType = graphql.NewObject(graphql.ObjectConfig{
+	Name:        "RouteStop",
+	Description: "A stop of a leg of a route",
+	Fields: graphql.Fields{
+		"id":                &graphql.Field{Type: graphql.String, Resolve: resolver(func(s dvb.RegularStop) any { return s.DataId })},
+		"name":              &graphql.Field{Type: nonNullString, Resolve: resolver(func(s dvb.RegularStop) any { return s.Name })},
+		"place":             &graphql.Field{Type: graphql.String, Resolve: resolver(func(s dvb.RegularStop) any { return s.Place })},
+		"platform":          &graphql.Field{Type: platformType, Resolve: resolver(func(s dvb.RegularStop) any { return s.Platform })},
+		"arrivalTime":       &graphql.Field{Type: graphql.DateTime, Resolve: resolver(func(s dvb.RegularStop) any { return timeValue(s.ArrivalTime.Time) })},
+		"departureTime":     &graphql.Field{Type: graphql.DateTime, Resolve: resolver(func(s dvb.RegularStop) any { return timeValue(s.DepartureTime.Time) })},
+		"arrivalRealTime":   &graphql.Field{Type: graphql.DateTime, Resolve: resolver(func(s dvb.RegularStop) any { return optionalTime(s.ArrivalRealTime) })},
+		"departureRealTime": &graphql.Field{Type: graphql.DateTime, Resolve: resolver(func(s dvb.RegularStop) any { return optionalTime(s.DepartureRealTime) })},
+		"latitude":          &graphql.Field{Type: graphql.Float, Resolve: resolver(func(s dvb.RegularStop) any { return latitude(s.WGS84()) })},
+		"longitude":         &graphql.Field{Type: graphql.Float, Resolve: resolver(func(s dvb.RegularStop) any { return longitude(s.WGS84()) })},
+	},
+})
+
+var legType = graphql.NewObject(graphql.ObjectConfig{
+	Name:        "Leg",
+	Description: "A part of a route travelled with one means of transport or on foot",
+	Fields: graphql.Fields{
+		"mot":       &graphql.Field{Type: graphql.String, Resolve: resolver(func(p dvb.PartialRoute) any { return p.Mot.Type.String() })},
+		"line":      &graphql.Field{Type: graphql.String, Resolve: resolver(func(p dvb.PartialRoute) any { return p.Mot.Name })},
+		"direction": &graphql.Field{Type: graphql.String, Resolve: resolver(func(p dvb.PartialRoute) any { return p.Mot.Direction })},
+		"duration":  &graphql.Field{Type: graphql.Int, Description: "Duration in minutes", Resolve: resolver(func(p dvb.PartialRoute) any { return p.Duration })},
+		"stops":     &graphql.Field{Type: graphql.NewList(graphql.NewNonNull(routeStopType)), Resolve: resolver(func(p dvb.PartialRoute) any { return p.RegularStops })},
+	},
+})
+
+var routeType = graphql.NewObject(graphql.ObjectConfig{
+	Name:        "Route",
+	Description: "A connection found by the trip planner",
+	Fields: graphql.Fields{
+		"duration":      &graphql.Field{Type: graphql.Int, Description: "Duration in minutes", Resolve: resolver(func(r dvb.Route) any { return r.Duration })},
+		"interchanges":  &graphql.Field{Type: graphql.Int, Resolve: resolver(func(r dvb.Route) any { return r.Interchanges })},
+		"price":         &graphql.Field{Type: graphql.String, Resolve: resolver(func(r dvb.Route) any { return r.Price })},
+		"departureTime": &graphql.Field{Type: graphql.DateTime, Resolve: resolver(func(r dvb.Route) any { return timeValue(r.DepartureTime()) })},
+		"arrivalTime":   &graphql.Field{Type: graphql.DateTime, Resolve: resolver(func(r dvb.Route) any { return timeValue(r.ArrivalTime()) })},
+		"legs":          &graphql.Field{Type: graphql.NewList(graphql.NewNonNull(legType)), Resolve: resolver(func(r dvb.Route) any { return r.PartialRoutes })},
+	},
+})
+
+// ignoreNotFound turns the errors of empty results into an empty list
+func ignoreNotFound[T any](items []T, err error) (any, error) {
+	var notFound *dvb.NotFoundError
+	if errors.As(err, &notFound) {
+		return []T{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,262 @@
+package dvbgrpc
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/niclaszll/dvb-go"
+	"github.com/niclaszll/dvb-go/coords"
+	"github.com/niclaszll/dvb-go/dvbgrpc/dvbpb"
+)
+
+// timestamp converts t, returning nil if it is unknown
+func timestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// optionalTimestamp converts t, returning nil if it is unknown
+func optionalTimestamp(t *dvb.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamp(t.Time)
+}
+
+// value dereferences v, returning the zero value for nil
+func value[T any](v *T) T {
+	if v == nil {
+		var zero T
+		return zero
+	}
+	return *v
+}
+
+// coordinate converts GK4 coordinates, returning nil if they are unknown
+func coordinate(x, y int) *dvbpb.Coordinate {
+	if x == 0 || y == 0 {
+		return nil
+	}
+	lat, lon := coords.GK4ToWGS84(float64(x), float64(y))
+	return &dvbpb.Coordinate{Gk4X: int64(x), Gk4Y: int64(y), Latitude: lat, Longitude: lon}
+}
+
+func apiStatus(s dvb.Status) *dvbpb.Status {
+	return &dvbpb.Status{Code: s.Code, Message: s.Message}
+}
+
+func platform(p dvb.Platform) *dvbpb.Platform {
+	return &dvbpb.Platform{Name: p.Name, Type: p.Type}
+}
+
+func diva(d dvb.Diva) *dvbpb.Diva {
+	return &dvbpb.Diva{Number: d.Number, Network: d.Network}
+}
+
+func monitorStopResponse(r *dvb.MonitorStopResponse) *dvbpb.MonitorStopResponse {
+	response := &dvbpb.MonitorStopResponse{
+		Name:           r.Name,
+		Place:          r.Place,
+		Status:         apiStatus(r.Status),
+		ExpirationTime: timestamp(r.ExpirationTime.Time),
+		ScheduledOnly:  r.ScheduledOnly,
+		Departures:     make([]*dvbpb.Departure, len(r.Departures)),
+	}
+	for i, d := range r.Departures {
+		response.Departures[i] = &dvbpb.Departure{
+			Id:            d.Id,
+			DlId:          d.DlId,
+			LineName:      d.LineName,
+			Direction:     d.Direction,
+			Platform:      platform(d.Platform),
+			Mot:           d.Mot.String(),
+			RealTime:      timestamp(d.RealTime.Time),
+			ScheduledTime: timestamp(d.ScheduledTime.Time),
+			State:         d.State,
+			RouteChanges:  d.RouteChanges,
+			Diva:          diva(d.Diva),
+			CancelReasons: d.CancelReasons,
+			Occupancy:     d.Occupancy.String(),
+		}
+	}
+	return response
+}
+
+func getRouteResponse(r *dvb.GetRouteResponse) *dvbpb.GetRouteResponse {
+	response := &dvbpb.GetRouteResponse{
+		SessionId: r.SessionId,
+		Status:    apiStatus(r.Status),
+		Routes:    make([]*dvbpb.Route, len(r.Routes)),
+	}
+	for i := range r.Routes {
+		response.Routes[i] = route(&r.Routes[i])
+	}
+	return response
+}
+
+func route(r *dvb.Route) *dvbpb.Route {
+	route := &dvbpb.Route{
+		RouteId:        int32(r.RouteId),
+		PriceLevel:     int32(r.PriceLevel),
+		Price:          r.Price,
+		PriceDayTicket: r.PriceDayTicket,
+		Net:            r.Net,
+		Duration:       int32(r.Duration),
+		Interchanges:   int32(r.Interchanges),
+		FareZoneNames:  r.FareZoneNames,
+		DepartureTime:  timestamp(r.DepartureTime()),
+		ArrivalTime:    timestamp(r.ArrivalTime()),
+		Synthesized:    r.Synthesized,
+	}
+	for _, m := range r.MotChain {
+		route.MotChain = append(route.MotChain, &dvbpb.MotChain{
+			DlId:                  m.DlId,
+			Type:                  m.Type.String(),
+			Name:                  m.Name,
+			Direction:             m.Direction,
+			Changes:               m.Changes,
+			Diva:                  diva(m.Diva),
+			TransportationCompany: m.TransportationCompany,
+			ProductName:           m.ProductName,
+			TrainNumber:           m.TrainNumber,
+		})
+	}
+	for _, p := range r.PartialRoutes {
+		route.PartialRoutes = append(route.PartialRoutes, partialRoute(r, p))
+	}
+	for _, t := range r.Tickets {
+		route.Tickets = append(route.Tickets, &dvbpb.Ticket{
+			Name:              t.Name,
+			PriceLevel:        int32(t.PriceLevel),
+			Price:             t.Price,
+			NumberOfFareZones: t.NumberOfFareZones,
+			FareZoneNames:     t.FareZoneNames,
+		})
+	}
+	return route
+}
+
+func partialRoute(r *dvb.Route, p dvb.PartialRoute) *dvbpb.PartialRoute {
+	partial := &dvbpb.PartialRoute{
+		Duration: int32(p.Duration),
+		Mot: &dvbpb.Mot{
+			Type:                  p.Mot.Type.String(),
+			DlId:                  value(p.Mot.DlId),
+			Name:                  value(p.Mot.Name),
+			Direction:             value(p.Mot.Direction),
+			Changes:               p.Mot.Changes,
+			TransportationCompany: value(p.Mot.TransportationCompany),
+			ProductName:           value(p.Mot.ProductName),
+			TrainNumber:           value(p.Mot.TrainNumber),
+		},
+		Shift:                p.Shift,
+		ChangeoverEndangered: value(p.ChangeoverEndangered),
+	}
+	if p.Mot.Diva != nil {
+		partial.Mot.Diva = diva(*p.Mot.Diva)
+	}
+	for _, s := range p.RegularStops {
+		partial.RegularStops = append(partial.RegularStops, &dvbpb.RegularStop{
+			DataId:            s.DataId,
+			Name:              s.Name,
+			Place:             s.Place,
+			Type:              s.Type,
+			Platform:          platform(s.Platform),
+			Coordinate:        coordinate(s.Latitude, s.Longitude),
+			ArrivalTime:       timestamp(s.ArrivalTime.Time),
+			DepartureTime:     timestamp(s.DepartureTime.Time),
+			ArrivalRealTime:   optionalTimestamp(s.ArrivalRealTime),
+			DepartureRealTime: optionalTimestamp(s.DepartureRealTime),
+			ArrivalState:      value(s.ArrivalState),
+			DepartureState:    value(s.DepartureState),
+			CancelReasons:     s.CancelReasons,
+			Occupancy:         s.Occupancy.String(),
+		})
+	}
+	if p.MapDataIndex != nil && *p.MapDataIndex < len(r.MapData) {
+		if segment, err := dvb.DecodeMapData(r.MapData[*p.MapDataIndex]); err == nil {
+			for _, c := range segment.Coordinates {
+				partial.Path = append(partial.Path, &dvbpb.Coordinate{
+					Gk4X:      int64(c.X),
+					Gk4Y:      int64(c.Y),
+					Latitude:  c.Latitude,
+					Longitude: c.Longitude,
+				})
+			}
+		}
+	}
+	return partial
+}
+
+func getLinesResponse(r *dvb.GetLinesResponse) *dvbpb.GetLinesResponse {
+	response := &dvbpb.GetLinesResponse{
+		Status:         apiStatus(r.Status),
+		ExpirationTime: timestamp(r.ExpirationTime.Time),
+		Lines:          make([]*dvbpb.Line, len(r.Lines)),
+	}
+	for i, l := range r.Lines {
+		line := &dvbpb.Line{Name: l.Name, Mot: l.Mot.String(), Changes: l.Changes, Diva: diva(l.Diva)}
+		for _, d := range l.Directions {
+			direction := &dvbpb.Direction{Name: d.Name}
+			for _, t := range d.TimeTables {
+				direction.TimeTables = append(direction.TimeTables, &dvbpb.TimeTable{Id: t.Id, Name: t.Name})
+			}
+			line.Directions = append(line.Directions, direction)
+		}
+		response.Lines[i] = line
+	}
+	return response
+}
+
+// pointTypes maps point types to their protobuf enum values
+var pointTypes = map[dvb.PointType]dvbpb.PointType{
+	dvb.PointStop:       dvbpb.PointType_POINT_TYPE_STOP,
+	dvb.PointAddress:    dvbpb.PointType_POINT_TYPE_ADDRESS,
+	dvb.PointPOI:        dvbpb.PointType_POINT_TYPE_POI,
+	dvb.PointCoordinate: dvbpb.PointType_POINT_TYPE_COORDINATE,
+}
+
+func getPointResponse(r *dvb.GetPointResponse) *dvbpb.GetPointResponse {
+	response := &dvbpb.GetPointResponse{
+		PointStatus:    r.PointStatus,
+		Status:         apiStatus(r.Status),
+		ExpirationTime: timestamp(r.ExpirationTime.Time),
+		Points:         make([]*dvbpb.Point, len(r.Points)),
+	}
+	for i, p := range r.Points {
+		response.Points[i] = &dvbpb.Point{
+			Id:         p.Id,
+			Type:       pointTypes[p.Type],
+			Place:      p.Place,
+			Name:       p.Name,
+			Coordinate: coordinate(p.X, p.Y),
+		}
+	}
+	return response
+}
+
+func getTripDetailsResponse(r *dvb.GetTripDetailsResponse) *dvbpb.GetTripDetailsResponse {
+	response := &dvbpb.GetTripDetailsResponse{
+		Status:         apiStatus(r.Status),
+		ExpirationTime: timestamp(r.ExpirationTime.Time),
+		Stops:          make([]*dvbpb.TripStop, len(r.Stops)),
+	}
+	for i, s := range r.Stops {
+		response.Stops[i] = &dvbpb.TripStop{
+			Id:         s.Id,
+			Name:       s.Name,
+			Place:      s.Place,
+			Position:   s.Position,
+			Platform:   platform(s.Platform),
+			Coordinate: coordinate(s.Latitude, s.Longitude),
+			Time:       timestamp(s.Time.Time),
+			RealTime:   optionalTimestamp(s.RealTime),
+			State:      value(s.State),
+			Occupancy:  s.Occupancy.String(),
+		}
+	}
+	return response
+}
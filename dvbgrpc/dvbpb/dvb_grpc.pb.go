@@ -0,0 +1,294 @@
+// Protobuf definitions mirroring the response types of the dvb client, served by
+// package dvbgrpc. Timestamps are converted to google.protobuf.Timestamp and
+// coordinates are additionally given in WGS84, so consumers do not have to
+// handle the API's date strings and Gauss-Krüger coordinates themselves.
+//
+// Regenerate the Go code with `go generate ./dvbgrpc/...`.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: dvb.proto
+
+package dvbpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	DVBService_MonitorStop_FullMethodName    = "/dvb.v1.DVBService/MonitorStop"
+	DVBService_GetRoute_FullMethodName       = "/dvb.v1.DVBService/GetRoute"
+	DVBService_GetLines_FullMethodName       = "/dvb.v1.DVBService/GetLines"
+	DVBService_GetPoint_FullMethodName       = "/dvb.v1.DVBService/GetPoint"
+	DVBService_GetTripDetails_FullMethodName = "/dvb.v1.DVBService/GetTripDetails"
+)
+
+// DVBServiceClient is the client API for DVBService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// DVBService exposes the endpoints of the VVO API
+type DVBServiceClient interface {
+	// MonitorStop returns real-time departures or arrivals at a stop
+	MonitorStop(ctx context.Context, in *MonitorStopRequest, opts ...grpc.CallOption) (*MonitorStopResponse, error)
+	// GetRoute plans connections between two points
+	GetRoute(ctx context.Context, in *GetRouteRequest, opts ...grpc.CallOption) (*GetRouteResponse, error)
+	// GetLines returns the lines serving a stop
+	GetLines(ctx context.Context, in *GetLinesRequest, opts ...grpc.CallOption) (*GetLinesResponse, error)
+	// GetPoint searches for stops, addresses and points of interest
+	GetPoint(ctx context.Context, in *GetPointRequest, opts ...grpc.CallOption) (*GetPointResponse, error)
+	// GetTripDetails returns all stops of a single trip
+	GetTripDetails(ctx context.Context, in *GetTripDetailsRequest, opts ...grpc.CallOption) (*GetTripDetailsResponse, error)
+}
+
+type dVBServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDVBServiceClient(cc grpc.ClientConnInterface) DVBServiceClient {
+	return &dVBServiceClient{cc}
+}
+
+func (c *dVBServiceClient) MonitorStop(ctx context.Context, in *MonitorStopRequest, opts ...grpc.CallOption) (*MonitorStopResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MonitorStopResponse)
+	err := c.cc.Invoke(ctx, DVBService_MonitorStop_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dVBServiceClient) GetRoute(ctx context.Context, in *GetRouteRequest, opts ...grpc.CallOption) (*GetRouteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRouteResponse)
+	err := c.cc.Invoke(ctx, DVBService_GetRoute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dVBServiceClient) GetLines(ctx context.Context, in *GetLinesRequest, opts ...grpc.CallOption) (*GetLinesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLinesResponse)
+	err := c.cc.Invoke(ctx, DVBService_GetLines_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dVBServiceClient) GetPoint(ctx context.Context, in *GetPointRequest, opts ...grpc.CallOption) (*GetPointResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPointResponse)
+	err := c.cc.Invoke(ctx, DVBService_GetPoint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dVBServiceClient) GetTripDetails(ctx context.Context, in *GetTripDetailsRequest, opts ...grpc.CallOption) (*GetTripDetailsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTripDetailsResponse)
+	err := c.cc.Invoke(ctx, DVBService_GetTripDetails_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DVBServiceServer is the server API for DVBService service.
+// All implementations must embed UnimplementedDVBServiceServer
+// for forward compatibility.
+//
+// DVBService exposes the endpoints of the VVO API
+type DVBServiceServer interface {
+	// MonitorStop returns real-time departures or arrivals at a stop
+	MonitorStop(context.Context, *MonitorStopRequest) (*MonitorStopResponse, error)
+	// GetRoute plans connections between two points
+	GetRoute(context.Context, *GetRouteRequest) (*GetRouteResponse, error)
+	// GetLines returns the lines serving a stop
+	GetLines(context.Context, *GetLinesRequest) (*GetLinesResponse, error)
+	// GetPoint searches for stops, addresses and points of interest
+	GetPoint(context.Context, *GetPointRequest) (*GetPointResponse, error)
+	// GetTripDetails returns all stops of a single trip
+	GetTripDetails(context.Context, *GetTripDetailsRequest) (*GetTripDetailsResponse, error)
+	mustEmbedUnimplementedDVBServiceServer()
+}
+
+// UnimplementedDVBServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDVBServiceServer struct{}
+
+func (UnimplementedDVBServiceServer) MonitorStop(context.Context, *MonitorStopRequest) (*MonitorStopResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MonitorStop not implemented")
+}
+func (UnimplementedDVBServiceServer) GetRoute(context.Context, *GetRouteRequest) (*GetRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoute not implemented")
+}
+func (UnimplementedDVBServiceServer) GetLines(context.Context, *GetLinesRequest) (*GetLinesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLines not implemented")
+}
+func (UnimplementedDVBServiceServer) GetPoint(context.Context, *GetPointRequest) (*GetPointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPoint not implemented")
+}
+func (UnimplementedDVBServiceServer) GetTripDetails(context.Context, *GetTripDetailsRequest) (*GetTripDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTripDetails not implemented")
+}
+func (UnimplementedDVBServiceServer) mustEmbedUnimplementedDVBServiceServer() {}
+func (UnimplementedDVBServiceServer) testEmbeddedByValue()                    {}
+
+// UnsafeDVBServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DVBServiceServer will
+// result in compilation errors.
+type UnsafeDVBServiceServer interface {
+	mustEmbedUnimplementedDVBServiceServer()
+}
+
+func RegisterDVBServiceServer(s grpc.ServiceRegistrar, srv DVBServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDVBServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DVBService_ServiceDesc, srv)
+}
+
+func _DVBService_MonitorStop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MonitorStopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DVBServiceServer).MonitorStop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DVBService_MonitorStop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DVBServiceServer).MonitorStop(ctx, req.(*MonitorStopRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DVBService_GetRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DVBServiceServer).GetRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DVBService_GetRoute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DVBServiceServer).GetRoute(ctx, req.(*GetRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DVBService_GetLines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLinesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DVBServiceServer).GetLines(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DVBService_GetLines_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DVBServiceServer).GetLines(ctx, req.(*GetLinesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DVBService_GetPoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DVBServiceServer).GetPoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DVBService_GetPoint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DVBServiceServer).GetPoint(ctx, req.(*GetPointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DVBService_GetTripDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTripDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DVBServiceServer).GetTripDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DVBService_GetTripDetails_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DVBServiceServer).GetTripDetails(ctx, req.(*GetTripDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DVBService_ServiceDesc is the grpc.ServiceDesc for DVBService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DVBService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dvb.v1.DVBService",
+	HandlerType: (*DVBServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "MonitorStop",
+			Handler:    _DVBService_MonitorStop_Handler,
+		},
+		{
+			MethodName: "GetRoute",
+			Handler:    _DVBService_GetRoute_Handler,
+		},
+		{
+			MethodName: "GetLines",
+			Handler:    _DVBService_GetLines_Handler,
+		},
+		{
+			MethodName: "GetPoint",
+			Handler:    _DVBService_GetPoint_Handler,
+		},
+		{
+			MethodName: "GetTripDetails",
+			Handler:    _DVBService_GetTripDetails_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dvb.proto",
+}
@@ -0,0 +1,5 @@
+// Package dvbpb contains the Go code generated from dvb.proto: the protobuf
+// messages mirroring the client's response types and the DVBService gRPC stubs.
+package dvbpb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative dvb.proto
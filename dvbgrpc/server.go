@@ -0,0 +1,185 @@
+// Package dvbgrpc serves the dvb client over gRPC, so services written in other
+// languages can consume DVB data without reimplementing the API's quirks. The
+// service and message definitions are in dvbpb/dvb.proto; generate clients for
+// other languages from that file.
+//
+// Example usage:
+//
+//	listener, err := net.Listen("tcp", ":9090")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	server := grpc.NewServer()
+//	dvbgrpc.Register(server, dvb.NewClient(dvb.Config{}))
+//	if err := server.Serve(listener); err != nil {
+//		log.Fatal(err)
+//	}
+package dvbgrpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/niclaszll/dvb-go"
+	"github.com/niclaszll/dvb-go/dvbgrpc/dvbpb"
+)
+
+// Server implements dvbpb.DVBServiceServer by forwarding requests to a client
+type Server struct {
+	dvbpb.UnimplementedDVBServiceServer
+
+	client dvb.API
+}
+
+var _ dvbpb.DVBServiceServer = (*Server)(nil)
+
+// NewServer creates a server answering requests with client
+func NewServer(client dvb.API) *Server {
+	return &Server{client: client}
+}
+
+// Register registers a server answering requests with client on registrar
+func Register(registrar grpc.ServiceRegistrar, client dvb.API) {
+	dvbpb.RegisterDVBServiceServer(registrar, NewServer(client))
+}
+
+// MonitorStop returns real-time departures or arrivals at a stop
+func (s *Server) MonitorStop(ctx context.Context, req *dvbpb.MonitorStopRequest) (*dvbpb.MonitorStopResponse, error) {
+	if req.GetStopId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "stop_id can not be empty")
+	}
+
+	params := &dvb.MonitorStopParams{
+		StopId:           req.GetStopId(),
+		IsArrival:        optional(req.GetIsArrival()),
+		ShortTermChanges: optional(req.GetShortTermChanges()),
+		MentzOnly:        optional(req.GetMentzOnly()),
+	}
+	if req.GetTime() != nil {
+		params.Time = dvb.TimePtr(req.GetTime().AsTime())
+	}
+	if req.GetLimit() > 0 {
+		params.Limit = dvb.Int(int(req.GetLimit()))
+	}
+
+	response, err := s.client.MonitorStop(ctx, params)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return monitorStopResponse(response), nil
+}
+
+// GetRoute plans connections between two points
+func (s *Server) GetRoute(ctx context.Context, req *dvbpb.GetRouteRequest) (*dvbpb.GetRouteResponse, error) {
+	if req.GetOrigin() == "" || req.GetDestination() == "" {
+		return nil, status.Error(codes.InvalidArgument, "origin and destination can not be empty")
+	}
+
+	params := &dvb.GetRouteParams{
+		Origin:           req.GetOrigin(),
+		Destination:      req.GetDestination(),
+		IsArrivalTime:    optional(req.GetIsArrivalTime()),
+		ShortTermChanges: optional(req.GetShortTermChanges()),
+		WalkingFallback:  optional(req.GetWalkingFallback()),
+	}
+	if req.GetTime() != nil {
+		params.Time = dvb.TimePtr(req.GetTime().AsTime())
+	}
+	if req.GetVia() != "" {
+		params.Via = dvb.String(req.GetVia())
+		if req.GetViaDwellTime() > 0 {
+			params.ViaDwellTime = dvb.Int(int(req.GetViaDwellTime()))
+		}
+	}
+	if req.GetSessionId() != "" {
+		params.SessionId = dvb.String(req.GetSessionId())
+	}
+
+	response, err := s.client.GetRoute(ctx, params)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return getRouteResponse(response), nil
+}
+
+// GetLines returns the lines serving a stop
+func (s *Server) GetLines(ctx context.Context, req *dvbpb.GetLinesRequest) (*dvbpb.GetLinesResponse, error) {
+	if req.GetStopId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "stop_id can not be empty")
+	}
+
+	response, err := s.client.GetLines(ctx, &dvb.GetLinesParams{StopId: req.GetStopId()})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return getLinesResponse(response), nil
+}
+
+// GetPoint searches for stops, addresses and points of interest
+func (s *Server) GetPoint(ctx context.Context, req *dvbpb.GetPointRequest) (*dvbpb.GetPointResponse, error) {
+	if req.GetQuery() == "" {
+		return nil, status.Error(codes.InvalidArgument, "query can not be empty")
+	}
+
+	params := &dvb.GetPointParams{
+		Query:         req.GetQuery(),
+		StopsOnly:     optional(req.GetStopsOnly()),
+		AssignedStops: optional(req.GetAssignedStops()),
+		Dvb:           optional(req.GetDvb()),
+	}
+	if req.GetLimit() > 0 {
+		params.Limit = dvb.Int(int(req.GetLimit()))
+	}
+
+	response, err := s.client.GetPoint(ctx, params)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return getPointResponse(response), nil
+}
+
+// GetTripDetails returns all stops of a single trip
+func (s *Server) GetTripDetails(ctx context.Context, req *dvbpb.GetTripDetailsRequest) (*dvbpb.GetTripDetailsResponse, error) {
+	if req.GetTripId() == "" || req.GetStopId() == "" || req.GetTime() == nil {
+		return nil, status.Error(codes.InvalidArgument, "trip_id, stop_id and time can not be empty")
+	}
+
+	response, err := s.client.GetTripDetails(ctx, &dvb.GetTripDetailsParams{
+		TripId: req.GetTripId(),
+		StopId: req.GetStopId(),
+		Time:   req.GetTime().AsTime(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return getTripDetailsResponse(response), nil
+}
+
+// optional returns a pointer to v if it is set, as proto3 scalars have no presence
+func optional(v bool) *bool {
+	if !v {
+		return nil
+	}
+	return &v
+}
+
+// toStatus maps client errors to gRPC status errors
+func toStatus(err error) error {
+	code := codes.Unavailable
+	var notFound *dvb.NotFoundError
+	switch {
+	case errors.As(err, &notFound):
+		code = codes.NotFound
+	case errors.Is(err, dvb.ErrValidation):
+		code = codes.InvalidArgument
+	case errors.Is(err, context.DeadlineExceeded):
+		code = codes.DeadlineExceeded
+	case errors.Is(err, context.Canceled):
+		code = codes.Canceled
+	}
+	return status.Error(code, err.Error())
+}
@@ -0,0 +1,137 @@
+// Package dvbmetrics exports client metrics (request counts, latency histograms,
+// errors, cache hits, retries and poller state) per API endpoint to Prometheus.
+//
+// Example usage:
+//
+//	collector, err := dvbmetrics.New(prometheus.DefaultRegisterer, dvbmetrics.Options{})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	client := dvb.NewClient(dvb.Config{
+//		Metrics: collector,
+//	})
+package dvbmetrics
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/niclaszll/dvb-go"
+)
+
+// Options configures the collector
+type Options struct {
+	// Namespace prefixes all metric names (optional, defaults to "dvb")
+	Namespace string
+
+	// Buckets are the latency histogram buckets in seconds (optional, defaults to prometheus.DefBuckets)
+	Buckets []float64
+
+	// ConstLabels are added to every metric, e.g. to tell several clients apart (optional)
+	ConstLabels prometheus.Labels
+}
+
+// Collector implements dvb.MetricsRecorder by updating Prometheus metrics
+type Collector struct {
+	requests *prometheus.CounterVec
+	errors   *prometheus.CounterVec
+	latency  *prometheus.HistogramVec
+	cache    *prometheus.CounterVec
+	retries  *prometheus.CounterVec
+	pollers  *prometheus.GaugeVec
+}
+
+var _ dvb.MetricsRecorder = (*Collector)(nil)
+
+// New creates a Collector and registers its metrics with the given registerer.
+// An error is returned if a metric with the same name is already registered.
+func New(registerer prometheus.Registerer, options Options) (*Collector, error) {
+	if options.Namespace == "" {
+		options.Namespace = "dvb"
+	}
+	if options.Buckets == nil {
+		options.Buckets = prometheus.DefBuckets
+	}
+
+	c := &Collector{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   options.Namespace,
+			Name:        "requests_total",
+			Help:        "Number of requests sent to the API by endpoint and status code.",
+			ConstLabels: options.ConstLabels,
+		}, []string{"endpoint", "code"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   options.Namespace,
+			Name:        "request_errors_total",
+			Help:        "Number of requests that failed with a network error or a 5xx response.",
+			ConstLabels: options.ConstLabels,
+		}, []string{"endpoint"}),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace:   options.Namespace,
+			Name:        "request_duration_seconds",
+			Help:        "Duration of requests sent to the API.",
+			Buckets:     options.Buckets,
+			ConstLabels: options.ConstLabels,
+		}, []string{"endpoint"}),
+		cache: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   options.Namespace,
+			Name:        "cache_lookups_total",
+			Help:        "Number of response cache lookups by result (hit or miss).",
+			ConstLabels: options.ConstLabels,
+		}, []string{"endpoint", "result"}),
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   options.Namespace,
+			Name:        "retries_total",
+			Help:        "Number of repeated requests, including failovers to mirrors.",
+			ConstLabels: options.ConstLabels,
+		}, []string{"endpoint"}),
+		pollers: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace:   options.Namespace,
+			Name:        "poller_running",
+			Help:        "Whether a background poller is running (1) or stopped (0).",
+			ConstLabels: options.ConstLabels,
+		}, []string{"name"}),
+	}
+
+	for _, collector := range []prometheus.Collector{c.requests, c.errors, c.latency, c.cache, c.retries, c.pollers} {
+		if err := registerer.Register(collector); err != nil {
+			return nil, fmt.Errorf("failed to register metric: %w", err)
+		}
+	}
+	return c, nil
+}
+
+func (c *Collector) RequestDone(endpoint string, statusCode int, duration time.Duration, err error) {
+	code := "error"
+	if err == nil {
+		code = strconv.Itoa(statusCode)
+	}
+	c.requests.WithLabelValues(endpoint, code).Inc()
+	c.latency.WithLabelValues(endpoint).Observe(duration.Seconds())
+	if err != nil || statusCode >= 500 {
+		c.errors.WithLabelValues(endpoint).Inc()
+	}
+}
+
+func (c *Collector) CacheLookup(endpoint string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	c.cache.WithLabelValues(endpoint, result).Inc()
+}
+
+func (c *Collector) Retry(endpoint string) {
+	c.retries.WithLabelValues(endpoint).Inc()
+}
+
+func (c *Collector) PollerState(name string, running bool) {
+	value := 0.0
+	if running {
+		value = 1
+	}
+	c.pollers.WithLabelValues(name).Set(value)
+}
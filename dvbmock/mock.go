@@ -0,0 +1,158 @@
+// Package dvbmock provides a fake implementation of dvb.API for unit tests of code
+// using the dvb client. Each call is answered by the configured function and
+// recorded, so tests can check which requests were made.
+//
+// Example usage:
+//
+//	fake := &dvbmock.Fake{
+//		MonitorStopFunc: func(ctx context.Context, params *dvb.MonitorStopParams) (*dvb.MonitorStopResponse, error) {
+//			return &dvb.MonitorStopResponse{Name: "Hauptbahnhof"}, nil
+//		},
+//	}
+//	runCodeUnderTest(fake)
+//	if len(fake.Calls()) != 1 {
+//		t.Fatal("expected one call")
+//	}
+package dvbmock
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/niclaszll/dvb-go"
+)
+
+// ErrNotConfigured is returned by calls whose function is not set
+var ErrNotConfigured = errors.New("dvbmock: call not configured")
+
+// Call is a recorded call
+type Call struct {
+	// Method is the name of the called method, e.g. "MonitorStop"
+	Method string
+
+	// Params is the params argument of the call, e.g. *dvb.MonitorStopParams
+	Params any
+}
+
+// Fake implements dvb.API by delegating to its functions. Unset functions return
+// ErrNotConfigured. A Fake is safe for concurrent use.
+type Fake struct {
+	MonitorStopFunc      func(ctx context.Context, params *dvb.MonitorStopParams) (*dvb.MonitorStopResponse, error)
+	GetRouteFunc         func(ctx context.Context, params *dvb.GetRouteParams) (*dvb.GetRouteResponse, error)
+	GetLinesFunc         func(ctx context.Context, params *dvb.GetLinesParams) (*dvb.GetLinesResponse, error)
+	GetPointFunc         func(ctx context.Context, params *dvb.GetPointParams) (*dvb.GetPointResponse, error)
+	GetTripDetailsFunc   func(ctx context.Context, params *dvb.GetTripDetailsParams) (*dvb.GetTripDetailsResponse, error)
+	GetRouteChangesFunc  func(ctx context.Context, params *dvb.GetRouteChangesParams) (*dvb.GetRouteChangesResponse, error)
+	GetTimetableFunc     func(ctx context.Context, params *dvb.GetTimetableParams) (*dvb.GetTimetableResponse, error)
+	GetEarlierRoutesFunc func(ctx context.Context, sessionId string) (*dvb.GetRouteResponse, error)
+	GetLaterRoutesFunc   func(ctx context.Context, sessionId string) (*dvb.GetRouteResponse, error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ dvb.API = (*Fake)(nil)
+
+// Calls returns the recorded calls in the order they were made
+func (f *Fake) Calls() []Call {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]Call(nil), f.calls...)
+}
+
+// CallsTo returns the recorded calls of the given method
+func (f *Fake) CallsTo(method string) []Call {
+	var calls []Call
+	for _, call := range f.Calls() {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// Reset clears the recorded calls
+func (f *Fake) Reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = nil
+}
+
+func (f *Fake) record(method string, params any) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, Call{Method: method, Params: params})
+}
+
+func (f *Fake) MonitorStop(ctx context.Context, params *dvb.MonitorStopParams, _ ...dvb.RequestOption) (*dvb.MonitorStopResponse, error) {
+	f.record("MonitorStop", params)
+	if f.MonitorStopFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return f.MonitorStopFunc(ctx, params)
+}
+
+func (f *Fake) GetRoute(ctx context.Context, params *dvb.GetRouteParams, _ ...dvb.RequestOption) (*dvb.GetRouteResponse, error) {
+	f.record("GetRoute", params)
+	if f.GetRouteFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return f.GetRouteFunc(ctx, params)
+}
+
+func (f *Fake) GetLines(ctx context.Context, params *dvb.GetLinesParams, _ ...dvb.RequestOption) (*dvb.GetLinesResponse, error) {
+	f.record("GetLines", params)
+	if f.GetLinesFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return f.GetLinesFunc(ctx, params)
+}
+
+func (f *Fake) GetPoint(ctx context.Context, params *dvb.GetPointParams, _ ...dvb.RequestOption) (*dvb.GetPointResponse, error) {
+	f.record("GetPoint", params)
+	if f.GetPointFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return f.GetPointFunc(ctx, params)
+}
+
+func (f *Fake) GetTripDetails(ctx context.Context, params *dvb.GetTripDetailsParams, _ ...dvb.RequestOption) (*dvb.GetTripDetailsResponse, error) {
+	f.record("GetTripDetails", params)
+	if f.GetTripDetailsFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return f.GetTripDetailsFunc(ctx, params)
+}
+
+func (f *Fake) GetRouteChanges(ctx context.Context, params *dvb.GetRouteChangesParams, _ ...dvb.RequestOption) (*dvb.GetRouteChangesResponse, error) {
+	f.record("GetRouteChanges", params)
+	if f.GetRouteChangesFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return f.GetRouteChangesFunc(ctx, params)
+}
+
+func (f *Fake) GetTimetable(ctx context.Context, params *dvb.GetTimetableParams, _ ...dvb.RequestOption) (*dvb.GetTimetableResponse, error) {
+	f.record("GetTimetable", params)
+	if f.GetTimetableFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return f.GetTimetableFunc(ctx, params)
+}
+
+func (f *Fake) GetEarlierRoutes(ctx context.Context, sessionId string, _ ...dvb.RequestOption) (*dvb.GetRouteResponse, error) {
+	f.record("GetEarlierRoutes", sessionId)
+	if f.GetEarlierRoutesFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return f.GetEarlierRoutesFunc(ctx, sessionId)
+}
+
+func (f *Fake) GetLaterRoutes(ctx context.Context, sessionId string, _ ...dvb.RequestOption) (*dvb.GetRouteResponse, error) {
+	f.record("GetLaterRoutes", sessionId)
+	if f.GetLaterRoutesFunc == nil {
+		return nil, ErrNotConfigured
+	}
+	return f.GetLaterRoutesFunc(ctx, sessionId)
+}
@@ -0,0 +1,54 @@
+package dvbserver
+
+import (
+	"net/http"
+	"slices"
+)
+
+// corsMaxAge is how long browsers may cache the result of a preflight request, in seconds
+const corsMaxAge = "86400"
+
+// cors allows browser frontends served from other origins to call the server
+type cors struct {
+	origins []string
+}
+
+// allowed returns the value of Access-Control-Allow-Origin for origin, or "" if
+// the origin is not allowed
+func (c *cors) allowed(origin string) string {
+	if slices.Contains(c.origins, "*") {
+		return "*"
+	}
+	if slices.Contains(c.origins, origin) {
+		return origin
+	}
+	return ""
+}
+
+// wrap adds CORS headers to responses and answers preflight requests
+func (c *cors) wrap(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Add("Vary", "Origin")
+
+		origin := req.Header.Get("Origin")
+		allowOrigin := ""
+		if origin != "" {
+			allowOrigin = c.allowed(origin)
+		}
+		if allowOrigin != "" {
+			w.Header().Set("Access-Control-Allow-Origin", allowOrigin)
+			w.Header().Set("Access-Control-Expose-Headers", "X-Cache, Retry-After")
+		}
+
+		if req.Method == http.MethodOptions && req.Header.Get("Access-Control-Request-Method") != "" {
+			if allowOrigin != "" {
+				w.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
+				w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+				w.Header().Set("Access-Control-Max-Age", corsMaxAge)
+			}
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		next.ServeHTTP(w, req)
+	})
+}
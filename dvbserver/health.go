@@ -0,0 +1,136 @@
+package dvbserver
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Check reports whether a dependency of the server is ready
+type Check func(ctx context.Context) error
+
+// checkTimeout limits how long a single readiness check may take
+const checkTimeout = 5 * time.Second
+
+// CheckResult is the outcome of a single readiness check
+type CheckResult struct {
+	Status    string    `json:"status"`
+	Error     string    `json:"error,omitempty"`
+	CheckedAt time.Time `json:"checkedAt"`
+}
+
+// HealthStatus is the JSON body returned by /healthz and /readyz
+type HealthStatus struct {
+	Status string                 `json:"status"`
+	Uptime string                 `json:"uptime"`
+	Checks map[string]CheckResult `json:"checks,omitempty"`
+}
+
+// health evaluates and caches readiness checks
+type health struct {
+	checks   map[string]Check
+	interval time.Duration
+	started  time.Time
+
+	mu           sync.Mutex
+	results      map[string]CheckResult
+	lastChecked  time.Time
+	shuttingDown bool
+}
+
+func newHealth(checks map[string]Check, interval time.Duration) *health {
+	return &health{
+		checks:   checks,
+		interval: interval,
+		started:  time.Now(),
+	}
+}
+
+// serveLiveness reports that the process is up and able to serve requests
+func (h *health) serveLiveness(w http.ResponseWriter, r *http.Request) {
+	writeHealth(w, http.StatusOK, HealthStatus{Status: "ok", Uptime: h.uptime()})
+}
+
+// serveReadiness runs (or reuses recent results of) all readiness checks
+func (h *health) serveReadiness(w http.ResponseWriter, r *http.Request) {
+	results, ready := h.evaluate(r.Context())
+
+	status := HealthStatus{Status: "ok", Uptime: h.uptime(), Checks: results}
+	code := http.StatusOK
+	if !ready {
+		status.Status = "unavailable"
+		code = http.StatusServiceUnavailable
+	}
+	writeHealth(w, code, status)
+}
+
+func (h *health) evaluate(ctx context.Context) (map[string]CheckResult, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.shuttingDown {
+		return map[string]CheckResult{"shutdown": {Status: "failing", Error: "server is shutting down", CheckedAt: time.Now()}}, false
+	}
+
+	if h.results == nil || time.Since(h.lastChecked) >= h.interval {
+		h.results = h.run(ctx)
+		h.lastChecked = time.Now()
+	}
+
+	ready := true
+	results := make(map[string]CheckResult, len(h.results))
+	for name, result := range h.results {
+		results[name] = result
+		if result.Status != "ok" {
+			ready = false
+		}
+	}
+	return results, ready
+}
+
+// run executes all checks concurrently
+func (h *health) run(ctx context.Context) map[string]CheckResult {
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	results := make(map[string]CheckResult, len(h.checks))
+	for name, check := range h.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result := CheckResult{Status: "ok"}
+			if err := check(ctx); err != nil {
+				result = CheckResult{Status: "failing", Error: err.Error()}
+			}
+			result.CheckedAt = time.Now()
+
+			mu.Lock()
+			results[name] = result
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	return results
+}
+
+// shutdown makes readiness fail from now on
+func (h *health) shutdown() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.shuttingDown = true
+}
+
+func (h *health) uptime() string {
+	return time.Since(h.started).Round(time.Second).String()
+}
+
+func writeHealth(w http.ResponseWriter, code int, status HealthStatus) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(status)
+}
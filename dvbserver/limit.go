@@ -0,0 +1,113 @@
+package dvbserver
+
+import (
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// bucket is the token bucket of a single client
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// clientLimiter limits the request rate of every client (by remote IP) separately,
+// so a misbehaving frontend can not exhaust the shared upstream connection
+type clientLimiter struct {
+	perSecond         float64
+	burst             float64
+	trustForwardedFor bool
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+func newClientLimiter(perSecond float64, burst int, trustForwardedFor bool) *clientLimiter {
+	if burst < 1 {
+		burst = max(1, int(math.Ceil(perSecond)))
+	}
+	return &clientLimiter{
+		perSecond:         perSecond,
+		burst:             float64(burst),
+		trustForwardedFor: trustForwardedFor,
+		buckets:           make(map[string]*bucket),
+		lastSweep:         time.Now(),
+	}
+}
+
+// wrap rejects requests of clients exceeding their rate with 429 Too Many Requests
+func (l *clientLimiter) wrap(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if delay := l.reserve(l.clientIP(req), time.Now()); delay > 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(delay.Seconds()))))
+			http.Error(w, "rate limit exceeded", http.StatusTooManyRequests)
+			return
+		}
+		next.ServeHTTP(w, req)
+	})
+}
+
+// reserve takes a token of the client if available and returns 0, otherwise the
+// time until the next token
+func (l *clientLimiter) reserve(client string, now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.sweep(now)
+
+	b, ok := l.buckets[client]
+	if !ok {
+		b = &bucket{tokens: l.burst, last: now}
+		l.buckets[client] = b
+	}
+	b.tokens = min(l.burst, b.tokens+now.Sub(b.last).Seconds()*l.perSecond)
+	b.last = now
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0
+	}
+	return time.Duration((1 - b.tokens) / l.perSecond * float64(time.Second))
+}
+
+// sweep removes buckets that have been refilled completely, as they are
+// equivalent to new ones. Requires l.mu.
+func (l *clientLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < time.Minute {
+		return
+	}
+	l.lastSweep = now
+
+	full := time.Duration(l.burst / l.perSecond * float64(time.Second))
+	for client, b := range l.buckets {
+		if now.Sub(b.last) >= full {
+			delete(l.buckets, client)
+		}
+	}
+}
+
+// clientIP identifies the client of req. Behind a trusted reverse proxy, the last
+// X-Forwarded-For entry is used: it is appended by the proxy itself, while earlier
+// entries are sent by the client and can be forged to evade the limit.
+func (l *clientLimiter) clientIP(req *http.Request) string {
+	if l.trustForwardedFor {
+		if values := req.Header.Values("X-Forwarded-For"); len(values) > 0 {
+			forwarded := values[len(values)-1]
+			client := strings.TrimSpace(forwarded[strings.LastIndex(forwarded, ",")+1:])
+			if net.ParseIP(client) != nil {
+				return client
+			}
+		}
+	}
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		return req.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,227 @@
+package dvbserver
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/niclaszll/dvb-go"
+)
+
+// RelayPaths are the upstream API paths served by the relay
+var RelayPaths = []string{"/dm", "/dm/trip", "/stt/lines", "/stt/timetable", "/tr/pointfinder", "/tr/trips", "/tr/prevnext", "/map/pins", "/rc"}
+
+// maxRelayBody limits the size of request bodies accepted by the relay
+const maxRelayBody = 1 << 20
+
+// relayResponse is a cached upstream response
+type relayResponse struct {
+	status  int
+	header  http.Header
+	body    []byte
+	expires time.Time
+}
+
+// relayEntry is a cached response in the relay's recency list
+type relayEntry struct {
+	key      string
+	response *relayResponse
+}
+
+// relayCall is an upstream request in flight that concurrent callers can wait for
+type relayCall struct {
+	done     chan struct{}
+	response *relayResponse
+	err      error
+}
+
+// relay forwards requests to the upstream API, caching responses until their
+// ExpirationTime and coalescing identical concurrent requests into one upstream call.
+// The cache holds up to maxEntries responses and evicts the least recently used one
+// when full. Its cache is shared with the REST endpoints.
+type relay struct {
+	client     *dvb.Client
+	defaultTTL time.Duration
+	maxTTL     time.Duration
+	maxEntries int
+
+	mu       sync.Mutex
+	cache    map[string]*list.Element
+	recency  *list.List // of *relayEntry, most recently used first
+	inflight map[string]*relayCall
+}
+
+func newRelay(client *dvb.Client, defaultTTL, maxTTL time.Duration, maxEntries int) *relay {
+	return &relay{
+		client:     client,
+		defaultTTL: defaultTTL,
+		maxTTL:     maxTTL,
+		maxEntries: maxEntries,
+		cache:      make(map[string]*list.Element),
+		recency:    list.New(),
+		inflight:   make(map[string]*relayCall),
+	}
+}
+
+func (r *relay) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet && req.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(req.Body, maxRelayBody))
+	if err != nil {
+		http.Error(w, "failed to read request body", http.StatusBadRequest)
+		return
+	}
+
+	key := relayKey(req, body)
+	response, cached := r.lookup(key)
+	if !cached {
+		response, err = r.fetch(req.Context(), key, func(ctx context.Context) (*relayResponse, error) {
+			return r.forward(ctx, req, body)
+		})
+		if err != nil {
+			http.Error(w, "upstream request failed", http.StatusBadGateway)
+			return
+		}
+	}
+
+	for name, values := range response.header {
+		for _, value := range values {
+			w.Header().Add(name, value)
+		}
+	}
+	if cached {
+		w.Header().Set("X-Cache", "HIT")
+	} else {
+		w.Header().Set("X-Cache", "MISS")
+	}
+	w.WriteHeader(response.status)
+	w.Write(response.body)
+}
+
+// lookup returns a cached, unexpired response
+func (r *relay) lookup(key string) (*relayResponse, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	element, ok := r.cache[key]
+	if !ok {
+		return nil, false
+	}
+	entry := element.Value.(*relayEntry)
+	if time.Now().After(entry.response.expires) {
+		r.remove(element)
+		return nil, false
+	}
+	r.recency.MoveToFront(element)
+	return entry.response, true
+}
+
+// fetch produces the response by calling produce, or waits for an identical request already in flight
+func (r *relay) fetch(ctx context.Context, key string, produce func(ctx context.Context) (*relayResponse, error)) (*relayResponse, error) {
+	r.mu.Lock()
+	if call, ok := r.inflight[key]; ok {
+		r.mu.Unlock()
+		select {
+		case <-call.done:
+			return call.response, call.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	call := &relayCall{done: make(chan struct{})}
+	r.inflight[key] = call
+	r.mu.Unlock()
+
+	// Detach from the first caller's cancellation, as others may be waiting for the result
+	call.response, call.err = produce(context.WithoutCancel(ctx))
+
+	r.mu.Lock()
+	delete(r.inflight, key)
+	if call.err == nil && call.response.status == http.StatusOK {
+		r.store(key, call.response)
+	}
+	r.mu.Unlock()
+	close(call.done)
+
+	return call.response, call.err
+}
+
+func (r *relay) forward(ctx context.Context, req *http.Request, body []byte) (*relayResponse, error) {
+	resp, err := r.client.Forward(ctx, req.Method, req.URL.Path, req.URL.Query(), body)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	header := http.Header{}
+	if contentType := resp.Header.Get("Content-Type"); contentType != "" {
+		header.Set("Content-Type", contentType)
+	}
+
+	return &relayResponse{
+		status:  resp.StatusCode,
+		header:  header,
+		body:    data,
+		expires: time.Now().Add(r.ttl(data)),
+	}, nil
+}
+
+// ttl derives the cache lifetime from the response's ExpirationTime, bounded by maxTTL
+func (r *relay) ttl(body []byte) time.Duration {
+	var envelope struct {
+		ExpirationTime dvb.Time `json:"ExpirationTime"`
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil || envelope.ExpirationTime.IsZero() {
+		return r.defaultTTL
+	}
+	return min(max(time.Until(envelope.ExpirationTime.Time), 0), r.maxTTL)
+}
+
+// store caches the response under key as the most recently used entry, evicting
+// the least recently used entries if the cache is full. Requires r.mu.
+func (r *relay) store(key string, response *relayResponse) {
+	if element, ok := r.cache[key]; ok {
+		element.Value.(*relayEntry).response = response
+		r.recency.MoveToFront(element)
+		return
+	}
+
+	r.cache[key] = r.recency.PushFront(&relayEntry{key: key, response: response})
+	for len(r.cache) > r.maxEntries {
+		r.remove(r.recency.Back())
+	}
+}
+
+// remove deletes a cached entry. Requires r.mu.
+func (r *relay) remove(element *list.Element) {
+	r.recency.Remove(element)
+	delete(r.cache, element.Value.(*relayEntry).key)
+}
+
+// relayKey identifies identical requests by method, path, query and body
+func relayKey(req *http.Request, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(req.Method))
+	hash.Write([]byte{0})
+	hash.Write([]byte(req.URL.Path))
+	hash.Write([]byte{0})
+	hash.Write([]byte(req.URL.Query().Encode()))
+	hash.Write([]byte{0})
+	hash.Write(bytes.TrimSpace(body))
+	return hex.EncodeToString(hash.Sum(nil))
+}
@@ -0,0 +1,209 @@
+package dvbserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/niclaszll/dvb-go"
+)
+
+// APIError is the JSON body returned by the REST endpoints if a request fails
+type APIError struct {
+	Error  string     `json:"error"`
+	Reason dvb.Reason `json:"reason,omitempty"`
+}
+
+// restHandler answers a REST request using the client and returns the value to encode
+type restHandler func(ctx context.Context, client *dvb.Client, req *http.Request) (any, error)
+
+// badRequest is returned by restHandlers for invalid query parameters
+type badRequest string
+
+func (e badRequest) Error() string {
+	return string(e)
+}
+
+// registerAPI adds the REST endpoints, sharing the cache of the relay
+func (s *Server) registerAPI(relay *relay, limit func(http.Handler) http.Handler) {
+	endpoints := map[string]restHandler{
+		"GET /api/departures/{stopId}": serveDepartures,
+		"GET /api/lines/{stopId}":      serveLines,
+		"GET /api/stops":               serveStops,
+		"GET /api/route":               serveRoute,
+		"GET /api/trips/{tripId}":      serveTrip,
+	}
+	for pattern, handler := range endpoints {
+		s.mux.Handle(pattern, limit(restEndpoint(s.client, relay, handler)))
+	}
+}
+
+// restEndpoint serves handler as JSON. Successful responses are cached until their
+// ExpirationTime and identical concurrent requests are coalesced.
+func restEndpoint(client *dvb.Client, relay *relay, handler restHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		key := relayKey(req, nil)
+		response, cached := relay.lookup(key)
+		if !cached {
+			var err error
+			response, err = relay.fetch(req.Context(), key, func(ctx context.Context) (*relayResponse, error) {
+				value, err := handler(ctx, client, req)
+				return restResponse(relay, value, err)
+			})
+			if err != nil {
+				http.Error(w, "failed to encode response", http.StatusInternalServerError)
+				return
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if cached {
+			w.Header().Set("X-Cache", "HIT")
+		} else {
+			w.Header().Set("X-Cache", "MISS")
+		}
+		if response.status == http.StatusOK {
+			maxAge := int(max(time.Until(response.expires), 0).Seconds())
+			w.Header().Set("Cache-Control", "public, max-age="+strconv.Itoa(maxAge))
+		} else {
+			w.Header().Set("Cache-Control", "no-store")
+		}
+		w.WriteHeader(response.status)
+		w.Write(response.body)
+	})
+}
+
+// restResponse encodes the result of a restHandler
+func restResponse(relay *relay, value any, err error) (*relayResponse, error) {
+	status := http.StatusOK
+	if err != nil {
+		status = errorStatus(err)
+		value = APIError{Error: err.Error(), Reason: dvb.ErrorReason(err)}
+	}
+
+	body, marshalErr := json.Marshal(value)
+	if marshalErr != nil {
+		return nil, marshalErr
+	}
+	return &relayResponse{
+		status:  status,
+		body:    body,
+		expires: time.Now().Add(relay.ttl(body)),
+	}, nil
+}
+
+// errorStatus maps client errors to HTTP status codes
+func errorStatus(err error) int {
+	var invalid badRequest
+	var notFound *dvb.NotFoundError
+	switch {
+	case errors.As(err, &invalid), errors.Is(err, dvb.ErrValidation):
+		return http.StatusBadRequest
+	case errors.As(err, &notFound):
+		return http.StatusNotFound
+	case errors.Is(err, context.DeadlineExceeded):
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusBadGateway
+	}
+}
+
+// serveDepartures handles GET /api/departures/{stopId}?limit=10&time=2025-01-01T12:00:00Z&arrival=true
+func serveDepartures(ctx context.Context, client *dvb.Client, req *http.Request) (any, error) {
+	options, err := queryOptions(req, "limit", "time", "arrival")
+	if err != nil {
+		return nil, err
+	}
+	return client.Departures(ctx, req.PathValue("stopId"), append(options, dvb.WithShortTermChanges())...)
+}
+
+// serveLines handles GET /api/lines/{stopId}
+func serveLines(ctx context.Context, client *dvb.Client, req *http.Request) (any, error) {
+	return client.Lines(ctx, req.PathValue("stopId"))
+}
+
+// serveStops handles GET /api/stops?q=Postplatz&limit=5&stopsOnly=true
+func serveStops(ctx context.Context, client *dvb.Client, req *http.Request) (any, error) {
+	query := req.URL.Query().Get("q")
+	if query == "" {
+		return nil, badRequest("q can not be empty")
+	}
+	options, err := queryOptions(req, "limit", "stopsOnly")
+	if err != nil {
+		return nil, err
+	}
+	return client.FindPoints(ctx, query, options...)
+}
+
+// serveRoute handles GET /api/route?from=33000028&to=33000037&time=2025-01-01T12:00:00Z&arrival=true
+func serveRoute(ctx context.Context, client *dvb.Client, req *http.Request) (any, error) {
+	from, to := req.URL.Query().Get("from"), req.URL.Query().Get("to")
+	if from == "" || to == "" {
+		return nil, badRequest("from and to can not be empty")
+	}
+	options, err := queryOptions(req, "time", "arrival")
+	if err != nil {
+		return nil, err
+	}
+	return client.PlanRoute(ctx, from, to, append(options, dvb.WithShortTermChanges())...)
+}
+
+// serveTrip handles GET /api/trips/{tripId}?stop=33000037&time=2025-01-01T12:00:00Z
+func serveTrip(ctx context.Context, client *dvb.Client, req *http.Request) (any, error) {
+	stopId := req.URL.Query().Get("stop")
+	if stopId == "" {
+		return nil, badRequest("stop can not be empty")
+	}
+	at, err := time.Parse(time.RFC3339, req.URL.Query().Get("time"))
+	if err != nil {
+		return nil, badRequest("time must be an RFC 3339 timestamp")
+	}
+	return client.GetTripDetails(ctx, &dvb.GetTripDetailsParams{
+		TripId: req.PathValue("tripId"),
+		StopId: stopId,
+		Time:   at,
+	})
+}
+
+// queryOptions converts the allowed query parameters of req to client options
+func queryOptions(req *http.Request, allowed ...string) ([]dvb.Option, error) {
+	query := req.URL.Query()
+	var options []dvb.Option
+	for _, name := range allowed {
+		value := query.Get(name)
+		if value == "" {
+			continue
+		}
+		switch name {
+		case "limit":
+			limit, err := strconv.Atoi(value)
+			if err != nil || limit < 1 {
+				return nil, badRequest("limit must be a positive integer")
+			}
+			options = append(options, dvb.WithLimit(limit))
+		case "time":
+			at, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return nil, badRequest("time must be an RFC 3339 timestamp")
+			}
+			options = append(options, dvb.WithTime(at))
+		case "arrival", "stopsOnly":
+			enabled, err := strconv.ParseBool(value)
+			if err != nil {
+				return nil, badRequest(name + " must be a boolean")
+			}
+			if !enabled {
+				continue
+			}
+			if name == "arrival" {
+				options = append(options, dvb.WithArrival())
+			} else {
+				options = append(options, dvb.WithStopsOnly())
+			}
+		}
+	}
+	return options, nil
+}
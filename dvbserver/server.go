@@ -0,0 +1,229 @@
+// Package dvbserver runs the dvb client as a long-lived HTTP service that can be
+// shared by multiple frontends. It provides the HTTP server scaffolding including
+// health and readiness endpoints for orchestrators like Kubernetes.
+//
+// With Options.API, the server acts as a small REST proxy for kiosk displays and
+// web widgets, so all of them share one upstream connection:
+//
+//	GET /api/departures/{stopId}?limit=10&time=2025-01-01T12:00:00Z&arrival=true
+//	GET /api/lines/{stopId}
+//	GET /api/stops?q=Postplatz&limit=5&stopsOnly=true
+//	GET /api/route?from=33000028&to=33000037&time=2025-01-01T12:00:00Z&arrival=true
+//	GET /api/trips/{tripId}?stop=33000037&time=2025-01-01T12:00:00Z
+//
+// Responses are the client's response types encoded as JSON, cached until their
+// ExpirationTime. Failed requests return an APIError with a matching status code.
+//
+// Example usage:
+//
+//	server := dvbserver.New(dvb.NewClient(dvb.Config{}), dvbserver.Options{})
+//	if err := server.ListenAndServe(ctx, ":8080"); err != nil {
+//		log.Fatal(err)
+//	}
+package dvbserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/niclaszll/dvb-go"
+)
+
+// Options holds configuration options for the server
+type Options struct {
+	// ReadinessChecks are evaluated by /readyz in addition to the upstream check (optional)
+	ReadinessChecks map[string]Check
+
+	// UpstreamProbe checks whether the upstream API is reachable
+	// (optional, defaults to a point finder query)
+	UpstreamProbe Check
+
+	// ProbeInterval is how long a readiness result is reused before checks run again,
+	// protecting the upstream API from aggressive probing (optional, defaults to 30s)
+	ProbeInterval time.Duration
+
+	// Relay serves the upstream API's own paths (/dm, /tr/trips, ...) as a shared
+	// caching relay, so thin clients can point their BaseURL at this server
+	Relay bool
+
+	// RelayDefaultTTL is how long relayed responses without ExpirationTime are cached
+	// (optional, defaults to 30s)
+	RelayDefaultTTL time.Duration
+
+	// RelayMaxTTL caps how long relayed responses are cached (optional, defaults to 5m)
+	RelayMaxTTL time.Duration
+
+	// RelayMaxEntries is the number of responses the relay keeps cached; the least
+	// recently used response is evicted when the cache is full (optional, defaults to 10000)
+	RelayMaxEntries int
+
+	// API serves the REST endpoints under /api/
+	API bool
+
+	// RateLimit is the maximum number of requests per second a single client may send
+	// to the relay and REST endpoints (optional, unlimited if 0). Requests above the
+	// limit are rejected with 429 Too Many Requests.
+	RateLimit float64
+
+	// RateBurst is the number of requests a client may send at once
+	// (optional, defaults to RateLimit rounded up)
+	RateBurst int
+
+	// TrustForwardedFor identifies clients by the last X-Forwarded-For entry instead of
+	// the remote address. Only enable it behind a reverse proxy appending the header.
+	TrustForwardedFor bool
+
+	// AllowedOrigins lists the origins browsers may call the server from, or "*"
+	// for any origin (optional, cross-origin requests are not allowed if empty)
+	AllowedOrigins []string
+
+	// ShutdownTimeout limits how long in-flight requests may take to finish
+	// when the server is shut down (optional, defaults to 10s)
+	ShutdownTimeout time.Duration
+}
+
+// Server is the HTTP server of the server mode
+type Server struct {
+	client  *dvb.Client
+	options Options
+	mux     *http.ServeMux
+	handler http.Handler
+	health  *health
+}
+
+// New creates a server using the given client for upstream requests
+func New(client *dvb.Client, options Options) *Server {
+	if options.ProbeInterval == 0 {
+		options.ProbeInterval = 30 * time.Second
+	}
+	if options.ShutdownTimeout == 0 {
+		options.ShutdownTimeout = 10 * time.Second
+	}
+	if options.RelayDefaultTTL == 0 {
+		options.RelayDefaultTTL = 30 * time.Second
+	}
+	if options.RelayMaxTTL == 0 {
+		options.RelayMaxTTL = 5 * time.Minute
+	}
+	if options.RelayMaxEntries <= 0 {
+		options.RelayMaxEntries = 10000
+	}
+	if options.UpstreamProbe == nil {
+		options.UpstreamProbe = func(ctx context.Context) error {
+			limit := 1
+			_, err := client.GetPoint(ctx, &dvb.GetPointParams{Query: "Postplatz", Limit: &limit})
+			return err
+		}
+	}
+
+	s := &Server{
+		client:  client,
+		options: options,
+		mux:     http.NewServeMux(),
+	}
+
+	checks := map[string]Check{"upstream": options.UpstreamProbe}
+	for name, check := range options.ReadinessChecks {
+		checks[name] = check
+	}
+	s.health = newHealth(checks, options.ProbeInterval)
+
+	s.mux.HandleFunc("GET /healthz", s.health.serveLiveness)
+	s.mux.HandleFunc("GET /readyz", s.health.serveReadiness)
+
+	limit := func(handler http.Handler) http.Handler { return handler }
+	if options.RateLimit > 0 {
+		limit = newClientLimiter(options.RateLimit, options.RateBurst, options.TrustForwardedFor).wrap
+	}
+
+	relay := newRelay(client, options.RelayDefaultTTL, options.RelayMaxTTL, options.RelayMaxEntries)
+	if options.Relay {
+		for _, path := range RelayPaths {
+			s.mux.Handle(path, limit(relay))
+		}
+	}
+	if options.API {
+		s.registerAPI(relay, limit)
+	}
+
+	s.handler = s.mux
+	if len(options.AllowedOrigins) > 0 {
+		s.handler = (&cors{origins: options.AllowedOrigins}).wrap(s.mux)
+	}
+	return s
+}
+
+// Handler returns the HTTP handler serving all endpoints of the server
+func (s *Server) Handler() http.Handler {
+	return s.handler
+}
+
+// Ready runs (or reuses recent results of) the readiness checks served by /readyz
+// and returns an error naming the first failing check, or nil if the server is ready.
+// It allows reporting readiness to supervisors like the systemd watchdog.
+func (s *Server) Ready(ctx context.Context) error {
+	results, ready := s.health.evaluate(ctx)
+	if ready {
+		return nil
+	}
+
+	names := make([]string, 0, len(results))
+	for name := range results {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		if result := results[name]; result.Status != "ok" {
+			return fmt.Errorf("readiness check %s failing: %s", name, result.Error)
+		}
+	}
+	return errors.New("server is not ready")
+}
+
+// ListenAndServe serves on addr until ctx is cancelled, then shuts down gracefully
+func (s *Server) ListenAndServe(ctx context.Context, addr string) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	return s.Serve(ctx, listener)
+}
+
+// Serve accepts connections on listener until ctx is cancelled, then shuts down
+// gracefully. Creating the listener separately allows signalling readiness
+// (e.g. to systemd) once the socket is bound.
+func (s *Server) Serve(ctx context.Context, listener net.Listener) error {
+	server := &http.Server{
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- server.Serve(listener)
+	}()
+
+	select {
+	case err := <-errs:
+		return err
+	case <-ctx.Done():
+	}
+
+	// Fail readiness first so load balancers stop routing new traffic
+	s.health.shutdown()
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), s.options.ShutdownTimeout)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		return err
+	}
+	if err := <-errs; !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+// Package dvbstatsd emits client metrics in the StatsD line protocol over UDP,
+// optionally with DogStatsD-style tags, for observability stacks that are not
+// based on Prometheus.
+//
+// Example usage:
+//
+//	sink, err := dvbstatsd.New(dvbstatsd.Config{Address: "127.0.0.1:8125", Prefix: "dvb"})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	defer sink.Close()
+//	client := dvb.NewClient(dvb.Config{Metrics: sink})
+package dvbstatsd
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/niclaszll/dvb-go"
+)
+
+// Config holds configuration options for the StatsD sink
+type Config struct {
+	Address string // Address of the StatsD agent (optional, defaults to 127.0.0.1:8125)
+	Network string // Network to dial (optional, defaults to udp)
+	Prefix  string // Prefix prepended to all metric names (optional)
+
+	// Tags emits the endpoint as DogStatsD tag ("|#endpoint:dm") instead of
+	// encoding it in the metric name ("dvb.dm.requests")
+	Tags bool
+}
+
+// Sink implements dvb.MetricsRecorder by sending StatsD packets
+type Sink struct {
+	config Config
+
+	mu   sync.Mutex
+	conn net.Conn
+}
+
+var _ dvb.MetricsRecorder = (*Sink)(nil)
+
+// New creates a Sink sending to the configured agent
+func New(config Config) (*Sink, error) {
+	if config.Address == "" {
+		config.Address = "127.0.0.1:8125"
+	}
+	if config.Network == "" {
+		config.Network = "udp"
+	}
+
+	conn, err := net.Dial(config.Network, config.Address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to statsd: %w", err)
+	}
+	return &Sink{config: config, conn: conn}, nil
+}
+
+// Close closes the connection to the agent
+func (s *Sink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.conn.Close()
+}
+
+func (s *Sink) RequestDone(endpoint string, statusCode int, duration time.Duration, err error) {
+	s.send("request.duration", endpoint, strconv.FormatFloat(float64(duration)/float64(time.Millisecond), 'f', 3, 64), "ms")
+	s.send("requests", endpoint, "1", "c")
+	if err != nil {
+		s.send("request.errors", endpoint, "1", "c")
+		return
+	}
+	s.send("status."+strconv.Itoa(statusCode), endpoint, "1", "c")
+}
+
+func (s *Sink) CacheLookup(endpoint string, hit bool) {
+	if hit {
+		s.send("cache.hits", endpoint, "1", "c")
+	} else {
+		s.send("cache.misses", endpoint, "1", "c")
+	}
+}
+
+func (s *Sink) Retry(endpoint string) {
+	s.send("retries", endpoint, "1", "c")
+}
+
+func (s *Sink) PollerState(name string, running bool) {
+	delta := "-1"
+	if running {
+		delta = "+1"
+	}
+	s.send("pollers.running", "", delta, "g")
+}
+
+// send writes a single metric; errors are ignored as StatsD is fire-and-forget
+func (s *Sink) send(name, endpoint, value, kind string) {
+	var b strings.Builder
+	if s.config.Prefix != "" {
+		b.WriteString(s.config.Prefix)
+		b.WriteByte('.')
+	}
+	if endpoint != "" && !s.config.Tags {
+		b.WriteString(sanitize(endpoint))
+		b.WriteByte('.')
+	}
+	b.WriteString(name)
+	b.WriteByte(':')
+	b.WriteString(value)
+	b.WriteByte('|')
+	b.WriteString(kind)
+	if endpoint != "" && s.config.Tags {
+		b.WriteString("|#endpoint:")
+		b.WriteString(sanitize(endpoint))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.conn.Write([]byte(b.String()))
+}
+
+// sanitize turns an API path like "/tr/trips" into a metric-safe segment "tr_trips"
+func sanitize(endpoint string) string {
+	endpoint = strings.Trim(endpoint, "/")
+	return strings.NewReplacer("/", "_", ".", "_", ":", "_", "|", "_", "@", "_", "#", "_").Replace(endpoint)
+}
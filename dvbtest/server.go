@@ -0,0 +1,209 @@
+// Package dvbtest provides a fake VVO API server for integration-style tests of
+// code using the dvb client. It serves canned fixtures for all endpoints the
+// client calls, with timestamps relative to the current time, and can inject
+// delays, errors and malformed payloads.
+//
+// Example usage:
+//
+//	server := dvbtest.NewServer()
+//	defer server.Close()
+//
+//	server.Inject("/dm", dvbtest.Fault{StatusCode: http.StatusServiceUnavailable, Times: 1})
+//	client := dvb.NewClient(dvb.Config{BaseURL: server.URL})
+//	response, err := client.MonitorStop(ctx, &dvb.MonitorStopParams{StopId: "33000028"})
+package dvbtest
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/niclaszll/dvb-go"
+)
+
+//go:embed fixtures/*.json
+var fixtureFiles embed.FS
+
+// Paths are the API paths served by the fake server
+var Paths = []string{"/dm", "/dm/trip", "/rc", "/stt/lines", "/stt/timetable", "/tr/pointfinder", "/tr/prevnext", "/tr/trips"}
+
+// Malformed is a truncated JSON payload, e.g. for Fault.Body
+const Malformed = `{"Status":{"Code":"Ok"},"Departures":[{"Id":`
+
+// Fault describes how requests to a path misbehave
+type Fault struct {
+	// Delay is waited before responding (optional)
+	Delay time.Duration
+
+	// StatusCode is sent instead of 200 (optional)
+	StatusCode int
+
+	// Body replaces the fixture, e.g. Malformed (optional)
+	Body string
+
+	// Drop closes the connection without a response, causing a network error
+	// (optional). net/http transparently retries idempotent requests once on a
+	// reused connection, so set Times to at least 2 to observe the error.
+	Drop bool
+
+	// Times is the number of requests affected before the fault is removed
+	// (optional, all requests if 0)
+	Times int
+}
+
+// Server is a fake VVO API server. Point dvb.Config.BaseURL at its URL.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	fixtures map[string]*template.Template
+	faults   map[string]*Fault
+	requests map[string]int
+}
+
+// NewServer starts a fake server serving the default fixtures. Close it when done.
+func NewServer() *Server {
+	s := &Server{
+		fixtures: make(map[string]*template.Template),
+		faults:   make(map[string]*Fault),
+		requests: make(map[string]int),
+	}
+	for _, path := range Paths {
+		name := "fixtures/" + strings.ReplaceAll(strings.TrimPrefix(path, "/"), "/", "_") + ".json"
+		data, err := fixtureFiles.ReadFile(name)
+		if err != nil {
+			panic(fmt.Sprintf("dvbtest: missing fixture %s", name))
+		}
+		s.fixtures[path] = parseFixture(path, string(data))
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	return s
+}
+
+// Client creates a dvb client using the fake server
+func (s *Server) Client(config dvb.Config) *dvb.Client {
+	config.BaseURL = s.URL
+	config.Mirrors = nil
+	return dvb.NewClient(config)
+}
+
+// SetFixture replaces the response body served for path. Like the default
+// fixtures, body may contain {{date N}}, which is replaced by the API timestamp
+// N minutes from the time of the request. It panics if the template is invalid.
+func (s *Server) SetFixture(path, body string) {
+	fixture := parseFixture(path, body)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fixtures[path] = fixture
+}
+
+// Inject makes requests to path misbehave as described by fault. An empty path
+// applies the fault to all paths. It replaces a previous fault for the path.
+func (s *Server) Inject(path string, fault Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults[path] = &fault
+}
+
+// ClearFaults removes all injected faults
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	clear(s.faults)
+}
+
+// Requests returns the number of requests received for path
+func (s *Server) Requests(path string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests[path]
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	fixture, fault, ok := s.take(r.URL.Path)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	if fault.Delay > 0 {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-time.After(fault.Delay):
+		}
+	}
+
+	if fault.Drop {
+		if hijacker, ok := w.(http.Hijacker); ok {
+			if conn, _, err := hijacker.Hijack(); err == nil {
+				conn.Close()
+				return
+			}
+		}
+		panic(http.ErrAbortHandler)
+	}
+
+	body := []byte(fault.Body)
+	if fault.Body == "" {
+		var buf bytes.Buffer
+		if err := fixture.Execute(&buf, nil); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		body = buf.Bytes()
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	if fault.StatusCode != 0 {
+		w.WriteHeader(fault.StatusCode)
+	}
+	w.Write(body)
+}
+
+// take records a request and returns the fixture and the fault to apply, if any
+func (s *Server) take(path string) (*template.Template, Fault, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fixture, ok := s.fixtures[path]
+	if !ok {
+		return nil, Fault{}, false
+	}
+	s.requests[path]++
+
+	key := path
+	fault, ok := s.faults[key]
+	if !ok {
+		key = ""
+		fault, ok = s.faults[key]
+	}
+	if !ok {
+		return fixture, Fault{}, true
+	}
+
+	if fault.Times > 0 {
+		fault.Times--
+		if fault.Times == 0 {
+			delete(s.faults, key)
+		}
+	}
+	return fixture, *fault, true
+}
+
+// parseFixture parses a fixture template, see SetFixture
+func parseFixture(path, body string) *template.Template {
+	funcs := template.FuncMap{
+		"date": func(minutes int) string {
+			return dvb.FormatDate(time.Now().Add(time.Duration(minutes) * time.Minute))
+		},
+	}
+	return template.Must(template.New(path).Funcs(funcs).Parse(body))
+}
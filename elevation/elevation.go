@@ -0,0 +1,194 @@
+// Package elevation computes elevation profiles of the walking legs of planned
+// routes by sampling an elevation source along the legs' geometry. This is
+// relevant for accessibility and cycling users who want to avoid steep climbs.
+//
+// Example usage:
+//
+//	source := elevation.NewSRTM("/var/lib/srtm")
+//	profiles, err := elevation.Profile(&response.Routes[0], source, elevation.Options{})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, profile := range profiles {
+//		fmt.Printf("Walk %.0fm: +%.0fm / -%.0fm\n", profile.Distance, profile.Climb, profile.Descent)
+//	}
+package elevation
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/niclaszll/dvb-go"
+)
+
+// ErrNoData is returned by sources that have no elevation data for a coordinate
+var ErrNoData = errors.New("no elevation data")
+
+// Source provides the elevation in meters above sea level at a WGS84 coordinate,
+// e.g. from SRTM tiles or a custom digital elevation model.
+type Source interface {
+	Elevation(lat, lon float64) (float64, error)
+}
+
+// SourceFunc adapts a function to the Source interface
+type SourceFunc func(lat, lon float64) (float64, error)
+
+// Elevation calls f(lat, lon)
+func (f SourceFunc) Elevation(lat, lon float64) (float64, error) {
+	return f(lat, lon)
+}
+
+// Options controls how legs are sampled
+type Options struct {
+	// Spacing is the distance in meters between two samples along a leg (optional, defaults to 10m)
+	Spacing float64
+
+	// Threshold is the minimum elevation change in meters counted towards climb
+	// and descent, filtering out noise of the elevation model (optional, defaults to 1m)
+	Threshold float64
+}
+
+// Sample is the elevation at a point of a leg
+type Sample struct {
+	// Distance is the distance in meters from the start of the leg
+	Distance float64
+
+	Lat, Lon  float64
+	Elevation float64
+}
+
+// LegProfile is the elevation profile of a single walking leg
+type LegProfile struct {
+	// Index is the index of the leg in Route.PartialRoutes
+	Index int
+
+	// Distance is the length of the leg in meters
+	Distance float64
+
+	// Climb and Descent are the accumulated elevation gain and loss in meters
+	Climb   float64
+	Descent float64
+
+	// MaxGrade is the steepest grade between two samples, as a fraction (0.08 = 8%)
+	MaxGrade float64
+
+	Samples []Sample
+}
+
+// Profile computes the elevation profiles of all walking legs of the route that
+// have geometry in the route's MapData. Legs without geometry are skipped.
+func Profile(route *dvb.Route, source Source, options Options) ([]LegProfile, error) {
+	if options.Spacing <= 0 {
+		options.Spacing = 10
+	}
+	if options.Threshold <= 0 {
+		options.Threshold = 1
+	}
+
+	var profiles []LegProfile
+	for i, partial := range route.PartialRoutes {
+		if partial.Mot.Type != dvb.MotFootpath || partial.MapDataIndex == nil {
+			continue
+		}
+		index := *partial.MapDataIndex
+		if index < 0 || index >= len(route.MapData) {
+			continue
+		}
+
+		points, err := decodeMapData(route.MapData[index])
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode geometry of leg %d: %w", i, err)
+		}
+		if len(points) < 2 {
+			continue
+		}
+
+		profile, err := profileLeg(points, source, options)
+		if err != nil {
+			return nil, fmt.Errorf("failed to profile leg %d: %w", i, err)
+		}
+		profile.Index = i
+		profiles = append(profiles, *profile)
+	}
+
+	return profiles, nil
+}
+
+// profileLeg samples the source along the polyline
+func profileLeg(points [][2]float64, source Source, options Options) (*LegProfile, error) {
+	profile := &LegProfile{}
+
+	sample := func(distance, lat, lon float64) error {
+		elevation, err := source.Elevation(lat, lon)
+		if err != nil {
+			return err
+		}
+		profile.Samples = append(profile.Samples, Sample{Distance: distance, Lat: lat, Lon: lon, Elevation: elevation})
+		return nil
+	}
+
+	if err := sample(0, points[0][0], points[0][1]); err != nil {
+		return nil, err
+	}
+	for i := 1; i < len(points); i++ {
+		from, to := points[i-1], points[i]
+		length := haversine(from, to)
+		steps := max(1, int(math.Ceil(length/options.Spacing)))
+		for step := 1; step <= steps; step++ {
+			t := float64(step) / float64(steps)
+			lat := from[0] + (to[0]-from[0])*t
+			lon := from[1] + (to[1]-from[1])*t
+			if err := sample(profile.Distance+length*t, lat, lon); err != nil {
+				return nil, err
+			}
+		}
+		profile.Distance += length
+	}
+
+	// Accumulate changes only once they exceed the threshold to ignore model noise
+	reference := profile.Samples[0]
+	for i, s := range profile.Samples[1:] {
+		previous := profile.Samples[i]
+		if run := s.Distance - previous.Distance; run > 0 {
+			profile.MaxGrade = max(profile.MaxGrade, math.Abs(s.Elevation-previous.Elevation)/run)
+		}
+
+		change := s.Elevation - reference.Elevation
+		if math.Abs(change) < options.Threshold {
+			continue
+		}
+		if change > 0 {
+			profile.Climb += change
+		} else {
+			profile.Descent -= change
+		}
+		reference = s
+	}
+
+	return profile, nil
+}
+
+// decodeMapData decodes a MapData entry into WGS84 coordinates
+func decodeMapData(mapData string) ([][2]float64, error) {
+	segment, err := dvb.DecodeMapData(mapData)
+	if err != nil {
+		return nil, err
+	}
+
+	points := make([][2]float64, 0, len(segment.Coordinates))
+	for _, c := range segment.Coordinates {
+		points = append(points, [2]float64{c.Latitude, c.Longitude})
+	}
+	return points, nil
+}
+
+// haversine returns the great-circle distance in meters between two coordinates
+func haversine(a, b [2]float64) float64 {
+	const earthRadius = 6371000
+	lat1, lat2 := a[0]*math.Pi/180, b[0]*math.Pi/180
+	dLat := lat2 - lat1
+	dLon := (b[1] - a[1]) * math.Pi / 180
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadius * math.Asin(math.Sqrt(h))
+}
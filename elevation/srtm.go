@@ -0,0 +1,133 @@
+package elevation
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// srtmVoid marks samples without data in SRTM tiles
+const srtmVoid = -32768
+
+// SRTM is a Source reading SRTM .hgt tiles (1 or 3 arc-second resolution) from a
+// directory. Tiles are named after their south-west corner, e.g. N51E013.hgt for
+// the area around Dresden, and are loaded on first use.
+type SRTM struct {
+	// Dir is the directory containing the .hgt files
+	Dir string
+
+	mu    sync.Mutex
+	tiles map[string]*srtmTile
+}
+
+// srtmTile is a loaded tile of size×size samples, stored row-major from north to south
+type srtmTile struct {
+	size    int
+	samples []int16
+}
+
+// NewSRTM creates an SRTM source reading tiles from dir.
+func NewSRTM(dir string) *SRTM {
+	return &SRTM{Dir: dir}
+}
+
+// Elevation returns the bilinearly interpolated elevation at the given coordinate.
+// Returns ErrNoData if the tile is missing or the surrounding samples are voids.
+func (s *SRTM) Elevation(lat, lon float64) (float64, error) {
+	south, west := math.Floor(lat), math.Floor(lon)
+	tile, err := s.tile(int(south), int(west))
+	if err != nil {
+		return 0, err
+	}
+
+	// Row 0 is the northern edge of the tile
+	last := float64(tile.size - 1)
+	row := (1 - (lat - south)) * last
+	col := (lon - west) * last
+	r0, c0 := int(math.Floor(row)), int(math.Floor(col))
+	r1, c1 := min(r0+1, tile.size-1), min(c0+1, tile.size-1)
+	dr, dc := row-float64(r0), col-float64(c0)
+
+	var values [4]float64
+	for i, rc := range [4][2]int{{r0, c0}, {r0, c1}, {r1, c0}, {r1, c1}} {
+		sample := tile.samples[rc[0]*tile.size+rc[1]]
+		if sample == srtmVoid {
+			return 0, ErrNoData
+		}
+		values[i] = float64(sample)
+	}
+
+	top := values[0]*(1-dc) + values[1]*dc
+	bottom := values[2]*(1-dc) + values[3]*dc
+	return top*(1-dr) + bottom*dr, nil
+}
+
+// tile returns the tile with the given south-west corner, loading it if necessary
+func (s *SRTM) tile(lat, lon int) (*srtmTile, error) {
+	name := tileName(lat, lon)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if tile, ok := s.tiles[name]; ok {
+		if tile == nil {
+			return nil, ErrNoData
+		}
+		return tile, nil
+	}
+	if s.tiles == nil {
+		s.tiles = make(map[string]*srtmTile)
+	}
+
+	tile, err := loadTile(filepath.Join(s.Dir, name))
+	if errors.Is(err, fs.ErrNotExist) {
+		// Remember missing tiles to avoid hitting the filesystem for every sample
+		s.tiles[name] = nil
+		return nil, ErrNoData
+	}
+	if err != nil {
+		return nil, err
+	}
+	s.tiles[name] = tile
+	return tile, nil
+}
+
+// tileName returns the file name of the tile with the given south-west corner
+func tileName(lat, lon int) string {
+	ns, ew := 'N', 'E'
+	if lat < 0 {
+		ns, lat = 'S', -lat
+	}
+	if lon < 0 {
+		ew, lon = 'W', -lon
+	}
+	return fmt.Sprintf("%c%02d%c%03d.hgt", ns, lat, ew, lon)
+}
+
+func loadTile(path string) (*srtmTile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var size int
+	switch len(data) {
+	case 1201 * 1201 * 2:
+		size = 1201
+	case 3601 * 3601 * 2:
+		size = 3601
+	default:
+		return nil, fmt.Errorf("invalid SRTM tile size %d bytes: %s", len(data), path)
+	}
+
+	samples := make([]int16, size*size)
+	for i := range samples {
+		samples[i] = int16(binary.BigEndian.Uint16(data[2*i:]))
+	}
+	return &srtmTile{size: size, samples: samples}, nil
+}
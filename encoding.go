@@ -0,0 +1,65 @@
+package dvb
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// EncodingOptions controls how query parameters are serialized before a request is built.
+// The zero value produces the same output as url.Values.Encode.
+type EncodingOptions struct {
+	// Separator is placed between key/value pairs (optional, defaults to "&").
+	// Some gateways expect ";" instead.
+	Separator string
+
+	// Unescaped lists characters that are kept as-is in keys and values instead of
+	// being percent-encoded, e.g. ":" for coordinate queries like "coord:4621900:5657497".
+	Unescaped string
+
+	// Encode replaces the built-in serialization entirely if set (optional)
+	Encode func(url.Values) string
+}
+
+// encode serializes the query according to the options, sorted by key
+func (o EncodingOptions) encode(query url.Values) string {
+	if o.Encode != nil {
+		return o.Encode(query)
+	}
+	if o.Separator == "" && o.Unescaped == "" {
+		return query.Encode()
+	}
+
+	separator := o.Separator
+	if separator == "" {
+		separator = "&"
+	}
+
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var buf strings.Builder
+	for _, key := range keys {
+		for _, value := range query[key] {
+			if buf.Len() > 0 {
+				buf.WriteString(separator)
+			}
+			buf.WriteString(o.escape(key))
+			buf.WriteByte('=')
+			buf.WriteString(o.escape(value))
+		}
+	}
+	return buf.String()
+}
+
+// escape query-escapes s, restoring the characters listed in Unescaped
+func (o EncodingOptions) escape(s string) string {
+	escaped := url.QueryEscape(s)
+	for _, r := range o.Unescaped {
+		escaped = strings.ReplaceAll(escaped, url.QueryEscape(string(r)), string(r))
+	}
+	return escaped
+}
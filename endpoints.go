@@ -0,0 +1,66 @@
+package dvb
+
+import (
+	"sync"
+	"time"
+)
+
+// endpoint is a single base URL the client can send requests to
+type endpoint struct {
+	baseURL string
+
+	mu             sync.Mutex
+	unhealthyUntil time.Time
+}
+
+// healthy reports whether the endpoint is currently considered usable
+func (e *endpoint) healthy(now time.Time) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return !now.Before(e.unhealthyUntil)
+}
+
+// markUnhealthy excludes the endpoint from being preferred for the given cooldown
+func (e *endpoint) markUnhealthy(cooldown time.Duration) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.unhealthyUntil = time.Now().Add(cooldown)
+}
+
+// markHealthy clears any previous failure
+func (e *endpoint) markHealthy() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.unhealthyUntil = time.Time{}
+}
+
+// endpointPool holds the primary base URL followed by its mirrors in order of preference
+type endpointPool struct {
+	endpoints []*endpoint
+	cooldown  time.Duration
+}
+
+func newEndpointPool(baseURLs []string, cooldown time.Duration) *endpointPool {
+	pool := &endpointPool{cooldown: cooldown}
+	for _, baseURL := range baseURLs {
+		pool.endpoints = append(pool.endpoints, &endpoint{baseURL: baseURL})
+	}
+	return pool
+}
+
+// candidates returns the endpoints to try for a request: healthy endpoints in order
+// of preference, followed by unhealthy ones as a last resort. Endpoints automatically
+// recover once their cooldown has passed.
+func (p *endpointPool) candidates() []*endpoint {
+	now := time.Now()
+	healthy := make([]*endpoint, 0, len(p.endpoints))
+	var unhealthy []*endpoint
+	for _, endpoint := range p.endpoints {
+		if endpoint.healthy(now) {
+			healthy = append(healthy, endpoint)
+		} else {
+			unhealthy = append(unhealthy, endpoint)
+		}
+	}
+	return append(healthy, unhealthy...)
+}
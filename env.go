@@ -0,0 +1,62 @@
+package dvb
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Environment variables read by Config.WithEnv
+const (
+	EnvBaseURL     = "DVB_BASE_URL"     // Base URL of the API
+	EnvMirrors     = "DVB_MIRRORS"      // Comma-separated mirror base URLs
+	EnvTimeout     = "DVB_TIMEOUT"      // HTTP timeout as Go duration, e.g. "15s"
+	EnvUserAgent   = "DVB_USER_AGENT"   // User agent string
+	EnvRateLimit   = "DVB_RATE_LIMIT"   // Maximum requests per second, e.g. "2.5"
+	EnvAPIKey      = "DVB_API_KEY"      // API key sent with every request
+	EnvBearerToken = "DVB_BEARER_TOKEN" // Static bearer token
+)
+
+// WithEnv returns a copy of the config with values overridden by the DVB_*
+// environment variables that are set. Environment variables take precedence over
+// values set in code or loaded from configuration files, which makes them suitable
+// for container deployments. Unset or empty variables leave the value unchanged.
+func (c Config) WithEnv() (Config, error) {
+	if value := os.Getenv(EnvBaseURL); value != "" {
+		c.BaseURL = value
+	}
+	if value := os.Getenv(EnvMirrors); value != "" {
+		c.Mirrors = nil
+		for _, mirror := range strings.Split(value, ",") {
+			if mirror = strings.TrimSpace(mirror); mirror != "" {
+				c.Mirrors = append(c.Mirrors, mirror)
+			}
+		}
+	}
+	if value := os.Getenv(EnvTimeout); value != "" {
+		timeout, err := time.ParseDuration(value)
+		if err != nil {
+			return c, fmt.Errorf("invalid %s: %w", EnvTimeout, err)
+		}
+		c.Timeout = timeout
+	}
+	if value := os.Getenv(EnvUserAgent); value != "" {
+		c.UserAgent = value
+	}
+	if value := os.Getenv(EnvRateLimit); value != "" {
+		rateLimit, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return c, fmt.Errorf("invalid %s: %w", EnvRateLimit, err)
+		}
+		c.RateLimit = rateLimit
+	}
+	if value := os.Getenv(EnvAPIKey); value != "" {
+		c.APIKey = value
+	}
+	if value := os.Getenv(EnvBearerToken); value != "" {
+		c.BearerToken = value
+	}
+	return c, nil
+}
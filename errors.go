@@ -1,6 +1,170 @@
 package dvb
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// statusOk is the Status.Code the API returns for successful requests
+const statusOk = "Ok"
+
+var (
+	// ErrStopNotFound indicates that the API does not know the requested stop ID
+	ErrStopNotFound = errors.New("stop not found")
+
+	// ErrNoDepartures indicates that the stop exists but has no departures in the requested window
+	ErrNoDepartures = errors.New("no departures found")
+
+	// ErrNoRoute indicates that the trip planner could not find a connection
+	ErrNoRoute = errors.New("no route found")
+
+	// ErrTripNotFound indicates that the API does not know the requested trip
+	ErrTripNotFound = errors.New("trip not found")
+
+	// ErrValidation indicates that the API rejected the request parameters
+	ErrValidation = errors.New("request rejected by the API")
+
+	// ErrServiceUnavailable indicates that the API or one of its backend systems
+	// failed to answer the request, although the HTTP request succeeded
+	ErrServiceUnavailable = errors.New("service unavailable")
+
+	// ErrInvalidStopId indicates that a stop ID is malformed, so the request was not
+	// sent, see IsStopId
+	ErrInvalidStopId = errors.New("invalid stop id")
+)
+
+// NotFoundError is returned when the API answered the request, but the requested
+// resource does not exist or the result is empty. Use errors.Is with ErrStopNotFound,
+// ErrNoDepartures, ErrNoRoute or ErrTripNotFound to distinguish the cases from transport
+// or API failures.
+type NotFoundError struct {
+	// Err is the sentinel error describing what was not found
+	Err error
+
+	// Query is the stop ID or search term that produced no result
+	Query string
+
+	// Status is the status the API returned along with the empty result
+	Status Status
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Err, e.Query)
+}
+
+func (e *NotFoundError) Unwrap() error {
+	return e.Err
+}
+
+// Reason classifies the error. The API's status is used if it is more specific
+// than the sentinel error, e.g. when origin and destination are identical.
+func (e *NotFoundError) Reason() Reason {
+	switch reason := e.Status.Reason(); reason {
+	case ReasonOk, ReasonUnknown, ReasonValidation:
+	default:
+		return reason
+	}
+
+	switch {
+	case errors.Is(e.Err, ErrStopNotFound):
+		return ReasonStopNotFound
+	case errors.Is(e.Err, ErrNoDepartures):
+		return ReasonNoDepartures
+	case errors.Is(e.Err, ErrNoRoute):
+		return ReasonNoRoute
+	case errors.Is(e.Err, ErrTripNotFound):
+		return ReasonTripNotFound
+	}
+	return ReasonUnknown
+}
+
+// EnglishMessage returns an English description of the error suitable for showing to users
+func (e *NotFoundError) EnglishMessage() string {
+	return e.Reason().English()
+}
+
+// StatusError is returned when the API answers with HTTP 200, but reports a failure
+// in the response's Status. Use errors.Is with ErrValidation or ErrServiceUnavailable
+// to distinguish the cases; unclassified statuses wrap neither.
+type StatusError struct {
+	// Err is the sentinel error describing the failure, nil if the status is unknown
+	Err error
+
+	// Status is the status returned by the API
+	Status Status
+}
+
+func (e *StatusError) Error() string {
+	if e.Status.Message == "" {
+		return fmt.Sprintf("API status %s", e.Status.Code)
+	}
+	return fmt.Sprintf("API status %s: %s", e.Status.Code, e.Status.Message)
+}
+
+func (e *StatusError) Unwrap() error {
+	return e.Err
+}
+
+// Reason classifies the error by the API's status
+func (e *StatusError) Reason() Reason {
+	return e.Status.Reason()
+}
+
+// statusResponse is implemented by responses carrying a Status
+type statusResponse interface {
+	apiStatus() Status
+}
+
+func (r *MonitorStopResponse) apiStatus() Status     { return r.Status }
+func (r *GetRouteResponse) apiStatus() Status        { return r.Status }
+func (r *GetLinesResponse) apiStatus() Status        { return r.Status }
+func (r *GetPointResponse) apiStatus() Status        { return r.Status }
+func (r *GetTripDetailsResponse) apiStatus() Status  { return r.Status }
+func (r *GetRouteChangesResponse) apiStatus() Status { return r.Status }
+
+// statusError converts a failure reported in the response's Status into an error.
+// Not-found statuses result in a *NotFoundError, all others in a *StatusError.
+// Returns nil if the status reports success.
+func statusError(status Status, query url.Values) error {
+	if status.Code == "" || status.Code == statusOk {
+		return nil
+	}
+
+	var notFound error
+	switch reason := status.Reason(); reason {
+	case ReasonOk:
+		return nil
+	case ReasonStopNotFound:
+		notFound = ErrStopNotFound
+	case ReasonNoDepartures:
+		notFound = ErrNoDepartures
+	case ReasonNoRoute:
+		notFound = ErrNoRoute
+	case ReasonTripNotFound:
+		notFound = ErrTripNotFound
+	case ReasonServiceUnavailable, ReasonRateLimited:
+		return &StatusError{Err: ErrServiceUnavailable, Status: status}
+	case ReasonUnknown:
+		return &StatusError{Status: status}
+	default:
+		return &StatusError{Err: ErrValidation, Status: status}
+	}
+	return &NotFoundError{Err: notFound, Query: statusQuery(query), Status: status}
+}
+
+// statusQuery returns the stop ID, trip ID or search term of a request for error messages
+func statusQuery(query url.Values) string {
+	for _, key := range []string{"stopid", "tripid", "query"} {
+		if value := query.Get(key); value != "" {
+			return value
+		}
+	}
+	if query.Has("origin") {
+		return query.Get("origin") + " → " + query.Get("destination")
+	}
+	return ""
+}
 
 type apiError struct {
 	StatusCode int    `json:"status_code,omitempty"`
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"time"
@@ -46,6 +47,10 @@ func monitorStopExample(ctx context.Context, client *dvb.Client) {
 	if err != nil {
 		log.Fatalf("Error fetching stop information: %v", err)
 	}
+	if len(response.Departures) == 0 {
+		fmt.Println("No departures found.")
+		return
+	}
 
 	// Display the results
 	fmt.Printf("Stop: %s\n", response.Name)
@@ -54,26 +59,23 @@ func monitorStopExample(ctx context.Context, client *dvb.Client) {
 	fmt.Printf("Expiration Time: %s\n", response.ExpirationTime)
 	fmt.Println()
 
-	if len(response.Departures) == 0 {
-		fmt.Println("No departures found.")
-	} else {
-		fmt.Printf("Found %d departures:\n", len(response.Departures))
-		fmt.Println("---")
+	fmt.Printf("Found %d departures:\n", len(response.Departures))
+	fmt.Println("---")
 
-		for i, departure := range response.Departures {
-			fmt.Printf("%d. Line %s → %s\n", i+1, departure.LineName, departure.Direction)
-			fmt.Printf("   Platform: %s (%s)\n", departure.Platform.Name, departure.Platform.Type)
-			fmt.Printf("   Scheduled: %s\n", departure.ScheduledTime)
-			fmt.Printf("   Real-time: %s\n", departure.RealTime)
-			fmt.Printf("   State: %s\n", departure.State)
-			if departure.Occupancy != "" {
-				fmt.Printf("   Occupancy: %s\n", departure.Occupancy)
-			}
-			if len(departure.RouteChanges) > 0 {
-				fmt.Printf("   Route Changes: %v\n", departure.RouteChanges)
-			}
-			fmt.Println()
+	for i, departure := range response.Departures {
+		fmt.Printf("%d. Line %s → %s\n", i+1, departure.LineName, departure.Direction)
+		fmt.Printf("   Platform: %s (%s)\n", departure.Platform.Name, departure.Platform.Type)
+		fmt.Printf("   Scheduled: %s\n", departure.ScheduledTime)
+		fmt.Printf("   Real-time: %s\n", departure.RealTime)
+		fmt.Printf("   State: %s\n", departure.State)
+		fmt.Printf("   Departs in: %s\n", departure.Countdown(time.Now(), dvb.English))
+		if departure.Occupancy.Known() {
+			fmt.Printf("   Occupancy: %s\n", departure.Occupancy)
 		}
+		if len(departure.RouteChanges) > 0 {
+			fmt.Printf("   Route Changes: %v\n", departure.RouteChanges)
+		}
+		fmt.Println()
 	}
 }
 
@@ -143,6 +145,10 @@ func getRouteExample(ctx context.Context, client *dvb.Client) {
 	fmt.Println("---")
 
 	routeResponse, err := client.GetRoute(ctx, routeOptions)
+	if errors.Is(err, dvb.ErrNoRoute) {
+		fmt.Println("No routes found.")
+		return
+	}
 	if err != nil {
 		log.Printf("Error fetching route: %v", err)
 		return
@@ -216,7 +222,7 @@ func getPointExample(ctx context.Context, client *dvb.Client) {
 		fmt.Println("---")
 
 		for i, point := range pointResponse.Points {
-			fmt.Printf("%d. %s\n", i+1, point)
+			fmt.Printf("%d. %s, %s (%s, %s)\n", i+1, point.Name, point.Place, point.Type, point.Id)
 		}
 	}
 	fmt.Println()
@@ -0,0 +1,119 @@
+package dvb
+
+import "time"
+
+// isExpired reports whether data expiring at expires is stale at now.
+// Data without a known expiration is always considered expired.
+func isExpired(expires, now time.Time) bool {
+	return expires.IsZero() || !now.Before(expires)
+}
+
+// refreshAfter returns the time until expires, or 0 if it has already passed or is unknown
+func refreshAfter(expires time.Time) time.Duration {
+	if expires.IsZero() {
+		return 0
+	}
+	return max(time.Until(expires), 0)
+}
+
+// ExpiresAt returns when the departures expire and should be refreshed, or the
+// zero time if the response carries no ExpirationTime.
+func (r *MonitorStopResponse) ExpiresAt() time.Time {
+	return r.ExpirationTime.Time
+}
+
+// IsExpired reports whether the departures are stale at now. Responses without
+// an ExpirationTime are always considered expired.
+func (r *MonitorStopResponse) IsExpired(now time.Time) bool {
+	return isExpired(r.ExpiresAt(), now)
+}
+
+// RefreshAfter returns how long the departures remain fresh, or 0 if they have expired.
+func (r *MonitorStopResponse) RefreshAfter() time.Duration {
+	return refreshAfter(r.ExpiresAt())
+}
+
+// ExpiresAt returns when the lines expire and should be refreshed, or the
+// zero time if the response carries no ExpirationTime.
+func (r *GetLinesResponse) ExpiresAt() time.Time {
+	return r.ExpirationTime.Time
+}
+
+// IsExpired reports whether the lines are stale at now. Responses without
+// an ExpirationTime are always considered expired.
+func (r *GetLinesResponse) IsExpired(now time.Time) bool {
+	return isExpired(r.ExpiresAt(), now)
+}
+
+// RefreshAfter returns how long the lines remain fresh, or 0 if they have expired.
+func (r *GetLinesResponse) RefreshAfter() time.Duration {
+	return refreshAfter(r.ExpiresAt())
+}
+
+// ExpiresAt returns when the points expire and should be refreshed, or the
+// zero time if the response carries no ExpirationTime.
+func (r *GetPointResponse) ExpiresAt() time.Time {
+	return r.ExpirationTime.Time
+}
+
+// IsExpired reports whether the points are stale at now. Responses without
+// an ExpirationTime are always considered expired.
+func (r *GetPointResponse) IsExpired(now time.Time) bool {
+	return isExpired(r.ExpiresAt(), now)
+}
+
+// RefreshAfter returns how long the points remain fresh, or 0 if they have expired.
+func (r *GetPointResponse) RefreshAfter() time.Duration {
+	return refreshAfter(r.ExpiresAt())
+}
+
+// ExpiresAt returns when the trip details expire and should be refreshed, or the
+// zero time if the response carries no ExpirationTime.
+func (r *GetTripDetailsResponse) ExpiresAt() time.Time {
+	return r.ExpirationTime.Time
+}
+
+// IsExpired reports whether the trip details are stale at now. Responses without
+// an ExpirationTime are always considered expired.
+func (r *GetTripDetailsResponse) IsExpired(now time.Time) bool {
+	return isExpired(r.ExpiresAt(), now)
+}
+
+// RefreshAfter returns how long the trip details remain fresh, or 0 if they have expired.
+func (r *GetTripDetailsResponse) RefreshAfter() time.Duration {
+	return refreshAfter(r.ExpiresAt())
+}
+
+// ExpiresAt returns when the route changes expire and should be refreshed, or the
+// zero time if the response carries no ExpirationTime.
+func (r *GetRouteChangesResponse) ExpiresAt() time.Time {
+	return r.ExpirationTime.Time
+}
+
+// IsExpired reports whether the route changes are stale at now. Responses without
+// an ExpirationTime are always considered expired.
+func (r *GetRouteChangesResponse) IsExpired(now time.Time) bool {
+	return isExpired(r.ExpiresAt(), now)
+}
+
+// RefreshAfter returns how long the route changes remain fresh, or 0 if they have expired.
+func (r *GetRouteChangesResponse) RefreshAfter() time.Duration {
+	return refreshAfter(r.ExpiresAt())
+}
+
+// ExpiresAt returns when the timetable expires and should be refreshed, or the
+// zero time if the response carries no ExpirationTime.
+func (r *GetTimetableResponse) ExpiresAt() time.Time {
+	return r.ExpirationTime.Time
+}
+
+// IsExpired reports whether the timetable is stale at now. Responses without
+// an ExpirationTime are always considered expired.
+func (r *GetTimetableResponse) IsExpired(now time.Time) bool {
+	return isExpired(r.ExpiresAt(), now)
+}
+
+// RefreshAfter returns how long the timetable remains fresh, or 0 if it has expired.
+func (r *GetTimetableResponse) RefreshAfter() time.Duration {
+	return refreshAfter(r.ExpiresAt())
+}
@@ -0,0 +1,116 @@
+package dvb
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// FileCache is a Cache storing responses as files in a directory, so cached
+// departures, lines and points survive process restarts, e.g. on Raspberry Pi
+// boards that are power cycled. Each entry is written to its own file atomically.
+// Failures to read or write entries are treated as cache misses.
+type FileCache struct {
+	dir string
+}
+
+// fileCacheEntry is the on-disk format of a cache entry
+type fileCacheEntry struct {
+	Key     string          `json:"key"`
+	Expires time.Time       `json:"expires"`
+	Body    json.RawMessage `json:"body"`
+}
+
+// NewFileCache creates a cache storing its entries in dir. The directory is
+// created when the first entry is stored. Use Prune to remove old entries.
+//
+// Example usage:
+//
+//	client := dvb.NewClient(dvb.Config{
+//		Cache: dvb.NewFileCache("/var/cache/dvb"),
+//	})
+func NewFileCache(dir string) *FileCache {
+	return &FileCache{dir: dir}
+}
+
+// Get returns the entry stored under key
+func (f *FileCache) Get(key string) (CacheEntry, bool) {
+	data, err := os.ReadFile(f.path(key))
+	if err != nil {
+		return CacheEntry{}, false
+	}
+
+	var entry fileCacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil || entry.Key != key {
+		return CacheEntry{}, false
+	}
+	return CacheEntry{Body: entry.Body, Expires: entry.Expires}, true
+}
+
+// Set stores the entry under key, replacing the previous file atomically
+func (f *FileCache) Set(key string, entry CacheEntry) {
+	data, err := json.Marshal(fileCacheEntry{Key: key, Expires: entry.Expires, Body: entry.Body})
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(f.dir, 0o755); err != nil {
+		return
+	}
+
+	tmp, err := os.CreateTemp(f.dir, ".tmp-*")
+	if err != nil {
+		return
+	}
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), f.path(key))
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+	}
+}
+
+// Prune removes entries that expired more than maxAge ago, e.g. periodically or
+// on startup. Keep maxAge at least as long as Config.StaleWhileRevalidate.
+func (f *FileCache) Prune(maxAge time.Duration) error {
+	files, err := os.ReadDir(f.dir)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	cutoff := time.Now().Add(-maxAge)
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), ".json") {
+			continue
+		}
+		path := filepath.Join(f.dir, file.Name())
+		data, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		var entry fileCacheEntry
+		if err := json.Unmarshal(data, &entry); err != nil || entry.Expires.Before(cutoff) {
+			if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// path returns the file of key. Keys contain paths and query strings, so they are
+// hashed into file names.
+func (f *FileCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(f.dir, hex.EncodeToString(sum[:])+".json")
+}
@@ -0,0 +1,26 @@
+package dvb
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+)
+
+// Forward sends a raw request to the API path (e.g. "/dm") and returns the
+// unprocessed response. It applies the client's endpoints, failover, headers,
+// authentication, rate limiting and metrics, which makes it suitable for relays
+// that speak the upstream API themselves. A non-empty body must be JSON.
+// The caller is responsible for closing the response body.
+func (c *Client) Forward(ctx context.Context, method, path string, query url.Values, body []byte) (*http.Response, error) {
+	opts := requestOptions{
+		Method: method,
+		Path:   path,
+		Query:  query,
+	}
+	if len(body) > 0 {
+		opts.Body = json.RawMessage(body)
+	}
+
+	return c.doRequest(ctx, opts)
+}
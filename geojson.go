@@ -0,0 +1,138 @@
+package dvb
+
+import "time"
+
+// FeatureCollection is a GeoJSON feature collection (RFC 7946). Marshal it with
+// encoding/json to render it with Leaflet, Mapbox or other map libraries.
+type FeatureCollection struct {
+	Type     string    `json:"type"`
+	Features []Feature `json:"features"`
+}
+
+// Feature is a GeoJSON feature
+type Feature struct {
+	Type       string         `json:"type"`
+	Geometry   Geometry       `json:"geometry"`
+	Properties map[string]any `json:"properties"`
+}
+
+// Geometry is a GeoJSON Point or LineString geometry. Positions are WGS84
+// longitude and latitude pairs, as required by GeoJSON.
+type Geometry struct {
+	Type        string `json:"type"`
+	Coordinates any    `json:"coordinates"`
+}
+
+func newFeatureCollection() FeatureCollection {
+	return FeatureCollection{Type: "FeatureCollection", Features: []Feature{}}
+}
+
+func pointFeature(lat, lon float64, properties map[string]any) Feature {
+	return Feature{
+		Type:       "Feature",
+		Geometry:   Geometry{Type: "Point", Coordinates: []float64{lon, lat}},
+		Properties: properties,
+	}
+}
+
+// ToGeoJSON returns the route as GeoJSON: a LineString per partial route with
+// the mode of transport, line name and direction, followed by a Point per stop
+// with its name, platform and times. Partial routes without geometry and stops
+// without coordinates are skipped.
+//
+// Example usage:
+//
+//	data, err := json.Marshal(route.ToGeoJSON())
+func (r *Route) ToGeoJSON() FeatureCollection {
+	collection := newFeatureCollection()
+
+	for i := range r.PartialRoutes {
+		partial := &r.PartialRoutes[i]
+
+		geometry := r.partialGeometry(i)
+		if len(geometry) < 2 {
+			continue
+		}
+		line := make([][]float64, 0, len(geometry))
+		for _, c := range geometry {
+			line = append(line, []float64{c.Longitude, c.Latitude})
+		}
+
+		properties := map[string]any{
+			"partialRouteIndex": i,
+			"mot":               partial.Mot.Type,
+			"duration":          partial.Duration,
+		}
+		if partial.Mot.Name != nil {
+			properties["line"] = *partial.Mot.Name
+		}
+		if partial.Mot.Direction != nil {
+			properties["direction"] = *partial.Mot.Direction
+		}
+		collection.Features = append(collection.Features, Feature{
+			Type:       "Feature",
+			Geometry:   Geometry{Type: "LineString", Coordinates: line},
+			Properties: properties,
+		})
+	}
+
+	for i := range r.PartialRoutes {
+		partial := &r.PartialRoutes[i]
+		for j := range partial.RegularStops {
+			stop := &partial.RegularStops[j]
+			lat, lon, ok := stop.WGS84()
+			if !ok {
+				continue
+			}
+
+			properties := map[string]any{
+				"partialRouteIndex": i,
+				"id":                stop.DataId,
+				"name":              stop.Name,
+				"place":             stop.Place,
+				"platform":          stop.Platform.Name,
+				"arrival":           formatGeoJSONTime(firstDate(stop.ArrivalRealTime, &stop.ArrivalTime)),
+				"departure":         formatGeoJSONTime(firstDate(stop.DepartureRealTime, &stop.DepartureTime)),
+			}
+			if partial.Mot.Name != nil {
+				properties["line"] = *partial.Mot.Name
+			}
+			collection.Features = append(collection.Features, pointFeature(lat, lon, properties))
+		}
+	}
+
+	return collection
+}
+
+// ToGeoJSON returns the point as GeoJSON collection with a single Point feature
+// carrying its ID, type, name and place. The collection is empty if the point has
+// no coordinates.
+func (p *Point) ToGeoJSON() FeatureCollection {
+	return Points{*p}.ToGeoJSON()
+}
+
+// ToGeoJSON returns the points as GeoJSON, skipping points without coordinates
+func (p Points) ToGeoJSON() FeatureCollection {
+	collection := newFeatureCollection()
+	for i := range p {
+		lat, lon, ok := p[i].WGS84()
+		if !ok {
+			continue
+		}
+		collection.Features = append(collection.Features, pointFeature(lat, lon, map[string]any{
+			"id":    p[i].Id,
+			"type":  string(p[i].Type),
+			"name":  p[i].Name,
+			"place": p[i].Place,
+		}))
+	}
+	return collection
+}
+
+// formatGeoJSONTime formats t as RFC 3339, returning nil for unknown times
+func formatGeoJSONTime(t time.Time) any {
+	if t.IsZero() {
+		return nil
+	}
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,114 @@
+package dvb
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// gpx is the root element of a GPX 1.1 document
+type gpx struct {
+	XMLName   xml.Name      `xml:"http://www.topografix.com/GPX/1/1 gpx"`
+	Version   string        `xml:"version,attr"`
+	Creator   string        `xml:"creator,attr"`
+	Waypoints []gpxWaypoint `xml:"wpt"`
+	Tracks    []gpxTrack    `xml:"trk"`
+}
+
+type gpxWaypoint struct {
+	Lat  float64    `xml:"lat,attr"`
+	Lon  float64    `xml:"lon,attr"`
+	Time *time.Time `xml:"time,omitempty"`
+	Name string     `xml:"name,omitempty"`
+	Desc string     `xml:"desc,omitempty"`
+	Type string     `xml:"type,omitempty"`
+}
+
+type gpxTrack struct {
+	Name     string          `xml:"name,omitempty"`
+	Type     string          `xml:"type,omitempty"`
+	Segments []gpxTrackPoint `xml:"trkseg>trkpt"`
+}
+
+type gpxTrackPoint struct {
+	Lat float64 `xml:"lat,attr"`
+	Lon float64 `xml:"lon,attr"`
+}
+
+// ToGPX writes the route as GPX 1.1 document to w: a track per partial route
+// with its geometry, and a waypoint per stop with its departure (or arrival)
+// time. Navigation and outdoor apps can import the result.
+//
+// Example usage:
+//
+//	file, err := os.Create("journey.gpx")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	defer file.Close()
+//	if err := route.ToGPX(file); err != nil {
+//		log.Fatal(err)
+//	}
+func (r *Route) ToGPX(w io.Writer) error {
+	document := gpx{Version: "1.1", Creator: "dvb-go"}
+
+	for i := range r.PartialRoutes {
+		partial := &r.PartialRoutes[i]
+
+		geometry := r.partialGeometry(i)
+		if len(geometry) >= 2 {
+			track := gpxTrack{Name: partialName(partial), Type: partial.Mot.Type.String()}
+			for _, c := range geometry {
+				track.Segments = append(track.Segments, gpxTrackPoint{Lat: c.Latitude, Lon: c.Longitude})
+			}
+			document.Tracks = append(document.Tracks, track)
+		}
+
+		for j := range partial.RegularStops {
+			stop := &partial.RegularStops[j]
+			lat, lon, ok := stop.WGS84()
+			if !ok {
+				continue
+			}
+
+			waypoint := gpxWaypoint{Lat: lat, Lon: lon, Name: stop.Name, Type: "Stop"}
+			if t := firstDate(stop.DepartureRealTime, &stop.DepartureTime, stop.ArrivalRealTime, &stop.ArrivalTime); !t.IsZero() {
+				t = t.UTC()
+				waypoint.Time = &t
+			}
+			var desc []string
+			if stop.Place != "" {
+				desc = append(desc, stop.Place)
+			}
+			if stop.Platform.Name != "" {
+				desc = append(desc, "Platform "+stop.Platform.Name)
+			}
+			waypoint.Desc = strings.Join(desc, ", ")
+			document.Waypoints = append(document.Waypoints, waypoint)
+		}
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return fmt.Errorf("failed to write GPX: %w", err)
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(document); err != nil {
+		return fmt.Errorf("failed to write GPX: %w", err)
+	}
+	return nil
+}
+
+// partialName describes a partial route, e.g. "Tram 3 → Wilder Mann"
+func partialName(partial *PartialRoute) string {
+	name := partial.Mot.Type.String()
+	if partial.Mot.Name != nil && *partial.Mot.Name != "" {
+		name += " " + *partial.Mot.Name
+	}
+	if partial.Mot.Direction != nil && *partial.Mot.Direction != "" {
+		name += " → " + *partial.Mot.Direction
+	}
+	return name
+}
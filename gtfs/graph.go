@@ -0,0 +1,232 @@
+package gtfs
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+)
+
+// Node is a station of the connectivity graph
+type Node struct {
+	Id   string
+	Name string
+	Lat  float64
+	Lon  float64
+}
+
+// EdgeKind distinguishes rides on a line from walking connections
+type EdgeKind string
+
+const (
+	EdgeLine     EdgeKind = "line"
+	EdgeFootpath EdgeKind = "footpath"
+)
+
+// Edge connects two stations that are consecutive stops of a line or within walking distance
+type Edge struct {
+	From string
+	To   string
+	Kind EdgeKind
+
+	// Lines lists the names of all lines running directly between both stations
+	Lines []string
+
+	// Distance is the straight-line distance in meters
+	Distance float64
+}
+
+// Graph is the stop/line connectivity graph of a feed
+type Graph struct {
+	Nodes []Node
+	Edges []Edge
+}
+
+// GraphOptions controls how the connectivity graph is built
+type GraphOptions struct {
+	// FootpathRadius adds footpath edges between stations closer than this many meters (optional, disabled if 0)
+	FootpathRadius float64
+}
+
+// Graph builds the connectivity graph of the feed. Platforms are merged into their
+// stations; line edges are directed and deduplicated across trips.
+func (f *Feed) Graph(opts GraphOptions) *Graph {
+	nodes := make(map[string]bool)
+	lines := make(map[[2]string]map[string]bool)
+	for tripId, stopTimes := range f.StopTimes {
+		trip := f.Trips[tripId]
+		if trip == nil {
+			continue
+		}
+		name := trip.RouteId
+		if route := f.Routes[trip.RouteId]; route != nil && route.ShortName != "" {
+			name = route.ShortName
+		}
+		for i := 0; i+1 < len(stopTimes); i++ {
+			from, to := f.stationOf(stopTimes[i].StopId), f.stationOf(stopTimes[i+1].StopId)
+			if from == to {
+				continue
+			}
+			nodes[from], nodes[to] = true, true
+			key := [2]string{from, to}
+			if lines[key] == nil {
+				lines[key] = make(map[string]bool)
+			}
+			lines[key][name] = true
+		}
+	}
+
+	graph := &Graph{}
+	for id := range nodes {
+		node := Node{Id: id}
+		if stop := f.Stops[id]; stop != nil {
+			node.Name, node.Lat, node.Lon = stop.Name, stop.Lat, stop.Lon
+		}
+		graph.Nodes = append(graph.Nodes, node)
+	}
+	sort.Slice(graph.Nodes, func(i, j int) bool { return graph.Nodes[i].Id < graph.Nodes[j].Id })
+
+	positions := make(map[string]Node, len(graph.Nodes))
+	for _, node := range graph.Nodes {
+		positions[node.Id] = node
+	}
+	for key, names := range lines {
+		edge := Edge{From: key[0], To: key[1], Kind: EdgeLine}
+		for name := range names {
+			edge.Lines = append(edge.Lines, name)
+		}
+		sort.Strings(edge.Lines)
+		edge.Distance = distance(positions[key[0]], positions[key[1]])
+		graph.Edges = append(graph.Edges, edge)
+	}
+
+	if opts.FootpathRadius > 0 {
+		for i, a := range graph.Nodes {
+			for _, b := range graph.Nodes[i+1:] {
+				if d := distance(a, b); d <= opts.FootpathRadius {
+					graph.Edges = append(graph.Edges,
+						Edge{From: a.Id, To: b.Id, Kind: EdgeFootpath, Distance: d},
+						Edge{From: b.Id, To: a.Id, Kind: EdgeFootpath, Distance: d})
+				}
+			}
+		}
+	}
+
+	sort.Slice(graph.Edges, func(i, j int) bool {
+		a, b := graph.Edges[i], graph.Edges[j]
+		if a.From != b.From {
+			return a.From < b.From
+		}
+		if a.To != b.To {
+			return a.To < b.To
+		}
+		return a.Kind < b.Kind
+	})
+	return graph
+}
+
+// WriteDOT writes the graph in Graphviz DOT format
+func (g *Graph) WriteDOT(w io.Writer) error {
+	var b strings.Builder
+	b.WriteString("digraph network {\n")
+	for _, node := range g.Nodes {
+		fmt.Fprintf(&b, "  %q [label=%q, pos=\"%f,%f!\"];\n", node.Id, node.Name, node.Lon, node.Lat)
+	}
+	for _, edge := range g.Edges {
+		label := strings.Join(edge.Lines, ",")
+		style := "solid"
+		if edge.Kind == EdgeFootpath {
+			style = "dashed"
+		}
+		fmt.Fprintf(&b, "  %q -> %q [label=%q, kind=%q, style=%s, distance=%.0f];\n",
+			edge.From, edge.To, label, edge.Kind, style, edge.Distance)
+	}
+	b.WriteString("}\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// WriteGraphML writes the graph in GraphML format, readable by Gephi and NetworkX
+func (g *Graph) WriteGraphML(w io.Writer) error {
+	type data struct {
+		Key   string `xml:"key,attr"`
+		Value string `xml:",chardata"`
+	}
+	type node struct {
+		Id   string `xml:"id,attr"`
+		Data []data `xml:"data"`
+	}
+	type edge struct {
+		Source string `xml:"source,attr"`
+		Target string `xml:"target,attr"`
+		Data   []data `xml:"data"`
+	}
+	type key struct {
+		Id   string `xml:"id,attr"`
+		For  string `xml:"for,attr"`
+		Name string `xml:"attr.name,attr"`
+		Type string `xml:"attr.type,attr"`
+	}
+	type graph struct {
+		EdgeDefault string `xml:"edgedefault,attr"`
+		Nodes       []node `xml:"node"`
+		Edges       []edge `xml:"edge"`
+	}
+	type graphML struct {
+		XMLName xml.Name `xml:"graphml"`
+		Xmlns   string   `xml:"xmlns,attr"`
+		Keys    []key    `xml:"key"`
+		Graph   graph    `xml:"graph"`
+	}
+
+	doc := graphML{
+		Xmlns: "http://graphml.graphdrawing.org/xmlns",
+		Keys: []key{
+			{Id: "name", For: "node", Name: "name", Type: "string"},
+			{Id: "lat", For: "node", Name: "lat", Type: "double"},
+			{Id: "lon", For: "node", Name: "lon", Type: "double"},
+			{Id: "kind", For: "edge", Name: "kind", Type: "string"},
+			{Id: "lines", For: "edge", Name: "lines", Type: "string"},
+			{Id: "distance", For: "edge", Name: "distance", Type: "double"},
+		},
+		Graph: graph{EdgeDefault: "directed"},
+	}
+	for _, n := range g.Nodes {
+		doc.Graph.Nodes = append(doc.Graph.Nodes, node{Id: n.Id, Data: []data{
+			{Key: "name", Value: n.Name},
+			{Key: "lat", Value: fmt.Sprint(n.Lat)},
+			{Key: "lon", Value: fmt.Sprint(n.Lon)},
+		}})
+	}
+	for _, e := range g.Edges {
+		doc.Graph.Edges = append(doc.Graph.Edges, edge{Source: e.From, Target: e.To, Data: []data{
+			{Key: "kind", Value: string(e.Kind)},
+			{Key: "lines", Value: strings.Join(e.Lines, ",")},
+			{Key: "distance", Value: fmt.Sprintf("%.0f", e.Distance)},
+		}})
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(doc); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// distance returns the great-circle distance between two nodes in meters
+func distance(a, b Node) float64 {
+	const earthRadius = 6371000
+	lat1, lat2 := a.Lat*math.Pi/180, b.Lat*math.Pi/180
+	dLat := lat2 - lat1
+	dLon := (b.Lon - a.Lon) * math.Pi / 180
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadius * math.Asin(math.Sqrt(h))
+}
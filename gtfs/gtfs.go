@@ -0,0 +1,299 @@
+// Package gtfs loads the static GTFS feed published by VVO/DVB into indexed
+// in-memory structures. Stops can be looked up by their GTFS stop ID as well as
+// by the numeric stop IDs used by the DVB API, so schedule data can be combined
+// with responses from the dvb client.
+//
+// Example usage:
+//
+//	feed, err := gtfs.Download(ctx, nil, gtfs.DefaultFeedURL)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	stop := feed.StopByAPIId("33000028") // Dresden Hauptbahnhof
+package gtfs
+
+import (
+	"sort"
+	"time"
+)
+
+// Agency represents an entry of agency.txt
+type Agency struct {
+	Id       string
+	Name     string
+	URL      string
+	Timezone string
+}
+
+// Stop represents an entry of stops.txt. Depending on LocationType this is
+// a stop or platform (0) or a station grouping several platforms (1).
+type Stop struct {
+	// Id is the GTFS stop ID, usually a DHID like "de:14612:28" or "de:14612:28:2:3"
+	Id string
+
+	// Code is the short code of the stop, if any
+	Code string
+
+	// Name is the display name of the stop
+	Name string
+
+	// Lat and Lon are the WGS84 coordinates of the stop
+	Lat float64
+	Lon float64
+
+	// LocationType distinguishes stops/platforms (0) from stations (1)
+	LocationType int
+
+	// ParentStation is the ID of the station this platform belongs to, if any
+	ParentStation string
+
+	// PlatformCode is the platform identifier (e.g. "1", "2A"), if any
+	PlatformCode string
+}
+
+// Route represents an entry of routes.txt, i.e. a line
+type Route struct {
+	Id        string
+	AgencyId  string
+	ShortName string
+	LongName  string
+
+	// Type is the GTFS route type (0 = tram, 2 = rail, 3 = bus, 4 = ferry, ...)
+	Type int
+}
+
+// Trip represents an entry of trips.txt, a single run of a vehicle along a route
+type Trip struct {
+	Id          string
+	RouteId     string
+	ServiceId   string
+	Headsign    string
+	DirectionId int
+	BlockId     string
+	ShapeId     string
+}
+
+// StopTime represents an entry of stop_times.txt
+type StopTime struct {
+	TripId string
+	StopId string
+
+	// Arrival and Departure are offsets from the start of the service day.
+	// They may exceed 24 hours for trips running past midnight.
+	Arrival   time.Duration
+	Departure time.Duration
+
+	// Interpolated is true if the feed gives no times for this stop (a stop that
+	// is not a timepoint). Arrival and Departure are then interpolated linearly
+	// between the surrounding timepoints of the trip by Index.
+	Interpolated bool
+
+	// Sequence orders the stops of a trip
+	Sequence int
+
+	// Headsign overrides the trip headsign from this stop on, if set
+	Headsign string
+}
+
+// Calendar represents an entry of calendar.txt, the regular weekly pattern of a service
+type Calendar struct {
+	ServiceId string
+
+	// Weekdays is indexed by time.Weekday
+	Weekdays [7]bool
+
+	StartDate time.Time
+	EndDate   time.Time
+}
+
+// CalendarDate represents an entry of calendar_dates.txt, an exception to the regular pattern
+type CalendarDate struct {
+	ServiceId string
+	Date      time.Time
+
+	// ExceptionType is 1 if the service was added and 2 if it was removed for Date
+	ExceptionType int
+}
+
+// Feed holds a parsed GTFS feed with lookup indexes
+type Feed struct {
+	// Location is the time zone of the feed, taken from agency.txt (defaults to UTC)
+	Location *time.Location
+
+	Agencies  map[string]*Agency
+	Stops     map[string]*Stop
+	Routes    map[string]*Route
+	Trips     map[string]*Trip
+	Calendars map[string]*Calendar
+
+	// CalendarDates lists the exceptions per service ID
+	CalendarDates map[string][]CalendarDate
+
+	// StopTimes lists the stop times per trip ID, ordered by sequence
+	StopTimes map[string][]StopTime
+
+	stopTimesByStop map[string][]*StopTime
+	tripsByRoute    map[string][]*Trip
+	children        map[string][]*Stop
+	apiStops        map[string]*Stop
+}
+
+// NewFeed creates an empty feed in the given time zone, e.g. to build a feed
+// from other sources and write it with WriteZip
+func NewFeed(location *time.Location) *Feed {
+	return &Feed{
+		Location:      location,
+		Agencies:      make(map[string]*Agency),
+		Stops:         make(map[string]*Stop),
+		Routes:        make(map[string]*Route),
+		Trips:         make(map[string]*Trip),
+		Calendars:     make(map[string]*Calendar),
+		CalendarDates: make(map[string][]CalendarDate),
+		StopTimes:     make(map[string][]StopTime),
+	}
+}
+
+// Index builds the secondary lookup structures. Feeds returned by Load are
+// indexed; call it after modifying a feed before using the lookup methods.
+func (f *Feed) Index() {
+	f.stopTimesByStop = make(map[string][]*StopTime)
+	for tripId, stopTimes := range f.StopTimes {
+		sort.Slice(stopTimes, func(i, j int) bool {
+			return stopTimes[i].Sequence < stopTimes[j].Sequence
+		})
+		interpolate(stopTimes)
+		f.StopTimes[tripId] = stopTimes
+		for i := range stopTimes {
+			st := &stopTimes[i]
+			f.stopTimesByStop[st.StopId] = append(f.stopTimesByStop[st.StopId], st)
+		}
+	}
+	for _, stopTimes := range f.stopTimesByStop {
+		sort.Slice(stopTimes, func(i, j int) bool {
+			return stopTimes[i].Departure < stopTimes[j].Departure
+		})
+	}
+
+	f.tripsByRoute = make(map[string][]*Trip)
+	for _, trip := range f.Trips {
+		f.tripsByRoute[trip.RouteId] = append(f.tripsByRoute[trip.RouteId], trip)
+	}
+
+	f.children = make(map[string][]*Stop)
+	f.apiStops = make(map[string]*Stop)
+	for _, stop := range f.Stops {
+		if stop.ParentStation != "" {
+			f.children[stop.ParentStation] = append(f.children[stop.ParentStation], stop)
+		}
+		if id, ok := StopIdFromDHID(stop.Id); ok && stop.ParentStation == "" {
+			f.apiStops[id] = stop
+		}
+	}
+}
+
+// StopByAPIId returns the stop (or station) for a DVB API stop ID like "33000028",
+// or nil if the feed contains no matching stop.
+func (f *Feed) StopByAPIId(stopId string) *Stop {
+	return f.apiStops[stopId]
+}
+
+// Platforms returns the stops belonging to the given station, or the stop itself
+// if it has no children.
+func (f *Feed) Platforms(stopId string) []*Stop {
+	if children := f.children[stopId]; len(children) > 0 {
+		return children
+	}
+	if stop, ok := f.Stops[stopId]; ok {
+		return []*Stop{stop}
+	}
+	return nil
+}
+
+// stationOf returns the parent station of a platform, or the stop ID itself
+func (f *Feed) stationOf(stopId string) string {
+	if stop := f.Stops[stopId]; stop != nil && stop.ParentStation != "" {
+		return stop.ParentStation
+	}
+	return stopId
+}
+
+// StopTimesAt returns all stop times at the given stop, ordered by departure.
+// Stations include the stop times of all their platforms.
+func (f *Feed) StopTimesAt(stopId string) []*StopTime {
+	var result []*StopTime
+	for _, platform := range f.Platforms(stopId) {
+		result = append(result, f.stopTimesByStop[platform.Id]...)
+	}
+	if len(f.children[stopId]) > 0 {
+		sort.Slice(result, func(i, j int) bool {
+			return result[i].Departure < result[j].Departure
+		})
+	}
+	return result
+}
+
+// TripsByRoute returns all trips of the given route
+func (f *Feed) TripsByRoute(routeId string) []*Trip {
+	return f.tripsByRoute[routeId]
+}
+
+// ServiceActive reports whether the service runs on the given date,
+// taking calendar exceptions into account.
+func (f *Feed) ServiceActive(serviceId string, date time.Time) bool {
+	day := serviceDate(date)
+	for _, exception := range f.CalendarDates[serviceId] {
+		if exception.Date.Equal(day) {
+			return exception.ExceptionType == 1
+		}
+	}
+
+	calendar, ok := f.Calendars[serviceId]
+	if !ok {
+		return false
+	}
+	if day.Before(calendar.StartDate) || day.After(calendar.EndDate) {
+		return false
+	}
+	return calendar.Weekdays[day.Weekday()]
+}
+
+// ServiceDay returns the start of the service day of date in the feed's time zone.
+// GTFS times are measured from noon minus 12 hours, which equals midnight
+// except on days with a daylight saving time change.
+func (f *Feed) ServiceDay(date time.Time) time.Time {
+	local := date.In(f.Location)
+	noon := time.Date(local.Year(), local.Month(), local.Day(), 12, 0, 0, 0, f.Location)
+	return noon.Add(-12 * time.Hour)
+}
+
+// serviceDate truncates date to a calendar day in UTC, matching how dates are parsed
+func serviceDate(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// interpolate fills in the times of the interpolated stop times of a trip, sorted
+// by sequence, evenly spaced between the surrounding timepoints. Stops before the
+// first or after the last timepoint take the time of the nearest timepoint.
+func interpolate(stopTimes []StopTime) {
+	previous := -1
+	for i := range stopTimes {
+		if stopTimes[i].Interpolated {
+			continue
+		}
+		for j := previous + 1; j < i; j++ {
+			at := stopTimes[i].Arrival
+			if previous >= 0 {
+				from := stopTimes[previous].Departure
+				at = from + (at-from)*time.Duration(j-previous)/time.Duration(i-previous)
+			}
+			stopTimes[j].Arrival, stopTimes[j].Departure = at, at
+		}
+		previous = i
+	}
+	if previous < 0 {
+		return
+	}
+	for j := previous + 1; j < len(stopTimes); j++ {
+		stopTimes[j].Arrival, stopTimes[j].Departure = stopTimes[previous].Departure, stopTimes[previous].Departure
+	}
+}
@@ -0,0 +1,39 @@
+package gtfs
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// dresdenMunicipality is the municipality key used in DHIDs of stops in Dresden
+const dresdenMunicipality = "de:14612"
+
+// StopIdFromDHID converts a station DHID like "de:14612:28" into the numeric stop ID
+// used by the DVB API ("33000028"). Only stations in Dresden follow this scheme;
+// ok is false for all other IDs, including platform DHIDs like "de:14612:28:2:3".
+func StopIdFromDHID(dhid string) (string, bool) {
+	number, ok := strings.CutPrefix(dhid, dresdenMunicipality+":")
+	if !ok || strings.Contains(number, ":") {
+		return "", false
+	}
+	n, err := strconv.Atoi(number)
+	if err != nil || n < 0 || n > 999999 {
+		return "", false
+	}
+	return fmt.Sprintf("33%06d", n), true
+}
+
+// DHIDFromStopId converts a numeric DVB API stop ID like "33000028" into the
+// station DHID used in the GTFS feed ("de:14612:28"). See StopIdFromDHID.
+func DHIDFromStopId(stopId string) (string, bool) {
+	number, ok := strings.CutPrefix(stopId, "33")
+	if !ok || len(number) != 6 {
+		return "", false
+	}
+	n, err := strconv.Atoi(number)
+	if err != nil {
+		return "", false
+	}
+	return fmt.Sprintf("%s:%d", dresdenMunicipality, n), true
+}
@@ -0,0 +1,113 @@
+package gtfs
+
+import "sort"
+
+// LineStops returns the DVB API stop IDs served by the line with the given name,
+// in the order of the line's longest trip followed by stops only served by other
+// variants. Stations without an API stop ID are returned with their GTFS stop ID.
+func (f *Feed) LineStops(line string) []string {
+	var longest []StopTime
+	var trips []*Trip
+	for _, route := range f.Routes {
+		if route.ShortName != line {
+			continue
+		}
+		for _, trip := range f.tripsByRoute[route.Id] {
+			trips = append(trips, trip)
+			if stopTimes := f.StopTimes[trip.Id]; len(stopTimes) > len(longest) {
+				longest = stopTimes
+			}
+		}
+	}
+
+	seen := make(map[string]bool)
+	var stops []string
+	add := func(stopId string) {
+		station := f.apiStation(stopId)
+		if !seen[station] {
+			seen[station] = true
+			stops = append(stops, station)
+		}
+	}
+
+	for _, stopTime := range longest {
+		add(stopTime.StopId)
+	}
+	for _, trip := range trips {
+		for _, stopTime := range f.StopTimes[trip.Id] {
+			add(stopTime.StopId)
+		}
+	}
+	return stops
+}
+
+// LineDirection is the stop sequence of a line in one direction
+type LineDirection struct {
+	DirectionId int
+	Headsign    string
+
+	// Stops are the DVB API stop IDs of the direction's longest trip, in travel order.
+	// Stations without an API stop ID are returned with their GTFS stop ID.
+	Stops []string
+}
+
+// LineDirections returns the stop sequences of the line with the given name per
+// direction and headsign, taken from the longest trip of each.
+func (f *Feed) LineDirections(line string) []LineDirection {
+	type variant struct {
+		directionId int
+		headsign    string
+	}
+	longest := make(map[variant][]StopTime)
+	for _, route := range f.Routes {
+		if route.ShortName != line {
+			continue
+		}
+		for _, trip := range f.tripsByRoute[route.Id] {
+			key := variant{trip.DirectionId, trip.Headsign}
+			if stopTimes := f.StopTimes[trip.Id]; len(stopTimes) > len(longest[key]) {
+				longest[key] = stopTimes
+			}
+		}
+	}
+
+	directions := make([]LineDirection, 0, len(longest))
+	for key, stopTimes := range longest {
+		direction := LineDirection{DirectionId: key.directionId, Headsign: key.headsign}
+		for _, stopTime := range stopTimes {
+			station := f.apiStation(stopTime.StopId)
+			if n := len(direction.Stops); n == 0 || direction.Stops[n-1] != station {
+				direction.Stops = append(direction.Stops, station)
+			}
+		}
+		directions = append(directions, direction)
+	}
+
+	sort.Slice(directions, func(i, j int) bool {
+		if directions[i].DirectionId != directions[j].DirectionId {
+			return directions[i].DirectionId < directions[j].DirectionId
+		}
+		return directions[i].Headsign < directions[j].Headsign
+	})
+	return directions
+}
+
+// APIStopIds returns the DVB API stop IDs of all stations in the feed, sorted
+func (f *Feed) APIStopIds() []string {
+	stopIds := make([]string, 0, len(f.apiStops))
+	for stopId := range f.apiStops {
+		stopIds = append(stopIds, stopId)
+	}
+	sort.Strings(stopIds)
+	return stopIds
+}
+
+// apiStation returns the DVB API stop ID of the station the stop belongs to,
+// or the station's GTFS stop ID if it has none
+func (f *Feed) apiStation(stopId string) string {
+	station := f.stationOf(stopId)
+	if id, ok := StopIdFromDHID(station); ok {
+		return id
+	}
+	return station
+}
@@ -0,0 +1,357 @@
+package gtfs
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultFeedURL is the location of the static GTFS feed published by VVO
+const DefaultFeedURL = "https://www.vvo-online.de/open_data/VVO_GTFS.zip"
+
+// Download fetches the GTFS zip archive from url and parses it.
+// If httpClient is nil, http.DefaultClient is used.
+func Download(ctx context.Context, httpClient *http.Client, url string) (*Feed, error) {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download feed: HTTP %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read feed: %w", err)
+	}
+
+	return Load(bytes.NewReader(data), int64(len(data)))
+}
+
+// LoadFile parses the GTFS zip archive at path
+func LoadFile(path string) (*Feed, error) {
+	archive, err := zip.OpenReader(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open feed: %w", err)
+	}
+	defer archive.Close()
+
+	return parse(&archive.Reader)
+}
+
+// Load parses a GTFS zip archive from r
+func Load(r io.ReaderAt, size int64) (*Feed, error) {
+	archive, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open feed: %w", err)
+	}
+
+	return parse(archive)
+}
+
+// parse reads all supported files of the archive into a Feed
+func parse(archive *zip.Reader) (*Feed, error) {
+	feed := NewFeed(time.UTC)
+
+	files := []struct {
+		name     string
+		required bool
+		parse    func(row) error
+	}{
+		{"agency.txt", false, feed.parseAgency},
+		{"stops.txt", true, feed.parseStop},
+		{"routes.txt", true, feed.parseRoute},
+		{"trips.txt", true, feed.parseTrip},
+		{"stop_times.txt", true, feed.parseStopTime},
+		{"calendar.txt", false, feed.parseCalendar},
+		{"calendar_dates.txt", false, feed.parseCalendarDate},
+	}
+
+	for _, file := range files {
+		f, err := archive.Open(file.name)
+		if errors.Is(err, fs.ErrNotExist) && !file.required {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to open %s: %w", file.name, err)
+		}
+
+		err = readCSV(f, file.parse)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", file.name, err)
+		}
+	}
+
+	feed.Index()
+	return feed, nil
+}
+
+// row gives access to the fields of a CSV record by column name
+type row struct {
+	columns map[string]int
+	record  []string
+}
+
+func (r row) get(name string) string {
+	i, ok := r.columns[name]
+	if !ok || i >= len(r.record) {
+		return ""
+	}
+	return strings.TrimSpace(r.record[i])
+}
+
+func (r row) int(name string) (int, error) {
+	value := r.get(name)
+	if value == "" {
+		return 0, nil
+	}
+	return strconv.Atoi(value)
+}
+
+func (r row) float(name string) (float64, error) {
+	value := r.get(name)
+	if value == "" {
+		return 0, nil
+	}
+	return strconv.ParseFloat(value, 64)
+}
+
+// readCSV calls fn for every record of the CSV file, keyed by its header
+func readCSV(r io.Reader, fn func(row) error) error {
+	reader := csv.NewReader(r)
+	reader.ReuseRecord = true
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return err
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))] = i
+	}
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := fn(row{columns: columns, record: record}); err != nil {
+			line, _ := reader.FieldPos(0)
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+	}
+}
+
+func (f *Feed) parseAgency(r row) error {
+	agency := &Agency{
+		Id:       r.get("agency_id"),
+		Name:     r.get("agency_name"),
+		URL:      r.get("agency_url"),
+		Timezone: r.get("agency_timezone"),
+	}
+	f.Agencies[agency.Id] = agency
+
+	if location, err := time.LoadLocation(agency.Timezone); err == nil && f.Location == time.UTC {
+		f.Location = location
+	}
+	return nil
+}
+
+func (f *Feed) parseStop(r row) error {
+	lat, err := r.float("stop_lat")
+	if err != nil {
+		return err
+	}
+	lon, err := r.float("stop_lon")
+	if err != nil {
+		return err
+	}
+	locationType, err := r.int("location_type")
+	if err != nil {
+		return err
+	}
+
+	stop := &Stop{
+		Id:            r.get("stop_id"),
+		Code:          r.get("stop_code"),
+		Name:          r.get("stop_name"),
+		Lat:           lat,
+		Lon:           lon,
+		LocationType:  locationType,
+		ParentStation: r.get("parent_station"),
+		PlatformCode:  r.get("platform_code"),
+	}
+	f.Stops[stop.Id] = stop
+	return nil
+}
+
+func (f *Feed) parseRoute(r row) error {
+	routeType, err := r.int("route_type")
+	if err != nil {
+		return err
+	}
+
+	route := &Route{
+		Id:        r.get("route_id"),
+		AgencyId:  r.get("agency_id"),
+		ShortName: r.get("route_short_name"),
+		LongName:  r.get("route_long_name"),
+		Type:      routeType,
+	}
+	f.Routes[route.Id] = route
+	return nil
+}
+
+func (f *Feed) parseTrip(r row) error {
+	directionId, err := r.int("direction_id")
+	if err != nil {
+		return err
+	}
+
+	trip := &Trip{
+		Id:          r.get("trip_id"),
+		RouteId:     r.get("route_id"),
+		ServiceId:   r.get("service_id"),
+		Headsign:    r.get("trip_headsign"),
+		DirectionId: directionId,
+		BlockId:     r.get("block_id"),
+		ShapeId:     r.get("shape_id"),
+	}
+	f.Trips[trip.Id] = trip
+	return nil
+}
+
+func (f *Feed) parseStopTime(r row) error {
+	arrival, hasArrival, err := parseOptionalTime(r.get("arrival_time"))
+	if err != nil {
+		return err
+	}
+	departure, hasDeparture, err := parseOptionalTime(r.get("departure_time"))
+	if err != nil {
+		return err
+	}
+	// A single given time applies to both arrival and departure
+	if !hasArrival {
+		arrival = departure
+	}
+	if !hasDeparture {
+		departure = arrival
+	}
+	sequence, err := r.int("stop_sequence")
+	if err != nil {
+		return err
+	}
+
+	stopTime := StopTime{
+		TripId:       r.get("trip_id"),
+		StopId:       r.get("stop_id"),
+		Arrival:      arrival,
+		Departure:    departure,
+		Interpolated: !hasArrival && !hasDeparture,
+		Sequence:     sequence,
+		Headsign:     r.get("stop_headsign"),
+	}
+	f.StopTimes[stopTime.TripId] = append(f.StopTimes[stopTime.TripId], stopTime)
+	return nil
+}
+
+func (f *Feed) parseCalendar(r row) error {
+	start, err := parseDate(r.get("start_date"))
+	if err != nil {
+		return err
+	}
+	end, err := parseDate(r.get("end_date"))
+	if err != nil {
+		return err
+	}
+
+	calendar := &Calendar{
+		ServiceId: r.get("service_id"),
+		StartDate: start,
+		EndDate:   end,
+	}
+	days := []string{"sunday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday"}
+	for weekday, day := range days {
+		calendar.Weekdays[weekday] = r.get(day) == "1"
+	}
+	f.Calendars[calendar.ServiceId] = calendar
+	return nil
+}
+
+func (f *Feed) parseCalendarDate(r row) error {
+	date, err := parseDate(r.get("date"))
+	if err != nil {
+		return err
+	}
+	exceptionType, err := r.int("exception_type")
+	if err != nil {
+		return err
+	}
+
+	serviceId := r.get("service_id")
+	f.CalendarDates[serviceId] = append(f.CalendarDates[serviceId], CalendarDate{
+		ServiceId:     serviceId,
+		Date:          date,
+		ExceptionType: exceptionType,
+	})
+	return nil
+}
+
+// parseOptionalTime parses a GTFS time that may be empty at stops that are not
+// timepoints. The second return value is false if the time is empty.
+func parseOptionalTime(s string) (time.Duration, bool, error) {
+	if s == "" {
+		return 0, false, nil
+	}
+	d, err := parseTime(s)
+	return d, err == nil, err
+}
+
+// parseTime parses a GTFS time "HH:MM:SS" into an offset from the start of the service day
+func parseTime(s string) (time.Duration, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 3 {
+		return 0, fmt.Errorf("invalid time %q", s)
+	}
+
+	var values [3]int
+	for i, part := range parts {
+		value, err := strconv.Atoi(part)
+		if err != nil {
+			return 0, fmt.Errorf("invalid time %q", s)
+		}
+		values[i] = value
+	}
+
+	return time.Duration(values[0])*time.Hour + time.Duration(values[1])*time.Minute + time.Duration(values[2])*time.Second, nil
+}
+
+// parseDate parses a GTFS date "YYYYMMDD"
+func parseDate(s string) (time.Time, error) {
+	return time.Parse("20060102", s)
+}
@@ -0,0 +1,82 @@
+package gtfs
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+	"time"
+)
+
+// testFeed returns a GTFS archive with the given files
+func testFeed(t *testing.T, files map[string]string) *bytes.Reader {
+	t.Helper()
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := archive.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := archive.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return bytes.NewReader(buf.Bytes())
+}
+
+func TestLoadInterpolatesEmptyStopTimes(t *testing.T) {
+	r := testFeed(t, map[string]string{
+		"stops.txt": "stop_id,stop_name,stop_lat,stop_lon\n" +
+			"a,A,51.0,13.7\nb,B,51.0,13.7\nc,C,51.0,13.7\nd,D,51.0,13.7\n",
+		"routes.txt": "route_id,route_short_name,route_type\nr,3,0\n",
+		"trips.txt":  "route_id,service_id,trip_id,direction_id\nr,s,t,0\n",
+		"stop_times.txt": "trip_id,arrival_time,departure_time,stop_id,stop_sequence\n" +
+			"t,08:00:00,08:00:00,a,1\n" +
+			"t,,,b,2\n" +
+			"t,,,c,3\n" +
+			"t,08:06:00,08:07:00,d,4\n",
+	})
+
+	feed, err := Load(r, r.Size())
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	want := []struct {
+		arrival, departure time.Duration
+		interpolated       bool
+	}{
+		{8 * time.Hour, 8 * time.Hour, false},
+		{8*time.Hour + 2*time.Minute, 8*time.Hour + 2*time.Minute, true},
+		{8*time.Hour + 4*time.Minute, 8*time.Hour + 4*time.Minute, true},
+		{8*time.Hour + 6*time.Minute, 8*time.Hour + 7*time.Minute, false},
+	}
+	stopTimes := feed.StopTimes["t"]
+	if len(stopTimes) != len(want) {
+		t.Fatalf("got %d stop times, want %d", len(stopTimes), len(want))
+	}
+	for i, w := range want {
+		st := stopTimes[i]
+		if st.Arrival != w.arrival || st.Departure != w.departure || st.Interpolated != w.interpolated {
+			t.Errorf("stop time %d = %v/%v interpolated %v, want %v/%v interpolated %v",
+				i, st.Arrival, st.Departure, st.Interpolated, w.arrival, w.departure, w.interpolated)
+		}
+	}
+
+	// Interpolated times are written back as empty, so a round trip keeps the feed unchanged
+	var buf bytes.Buffer
+	if err := feed.WriteZip(&buf); err != nil {
+		t.Fatalf("WriteZip: %v", err)
+	}
+	reloaded, err := Load(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("Load written feed: %v", err)
+	}
+	if got := reloaded.StopTimes["t"][1]; !got.Interpolated || got.Arrival != want[1].arrival {
+		t.Errorf("reloaded stop time 1 = %v interpolated %v, want %v interpolated true", got.Arrival, got.Interpolated, want[1].arrival)
+	}
+}
@@ -0,0 +1,110 @@
+package gtfs
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/niclaszll/dvb-go"
+)
+
+// ErrNoMatch is returned if no GTFS trip corresponds to a departure
+var ErrNoMatch = errors.New("no matching trip found")
+
+// Match links a live departure to a trip of the static feed
+type Match struct {
+	Trip  *Trip
+	Route *Route
+
+	// StopTime is the trip's stop time at the monitored stop
+	StopTime *StopTime
+
+	// ServiceDay is the start of the service day the trip runs on
+	ServiceDay time.Time
+}
+
+// Headsign returns the headsign shown at the matched stop, falling back to the trip headsign
+func (m *Match) Headsign() string {
+	if m.StopTime.Headsign != "" {
+		return m.StopTime.Headsign
+	}
+	return m.Trip.Headsign
+}
+
+// Matcher links departures returned by MonitorStop to the trips of a static feed
+// by comparing line name, direction and scheduled time.
+type Matcher struct {
+	feed *Feed
+
+	// Tolerance is the maximum difference between the scheduled departure time
+	// of the API and the feed (defaults to one minute)
+	Tolerance time.Duration
+}
+
+// NewMatcher creates a Matcher for the given feed
+func NewMatcher(feed *Feed) *Matcher {
+	return &Matcher{feed: feed, Tolerance: time.Minute}
+}
+
+// Match finds the trip serving the departure at the stop with the given DVB API stop ID.
+// If several trips qualify, the one whose headsign matches the departure's direction
+// and whose scheduled time is closest wins. Returns ErrNoMatch if no trip qualifies.
+func (m *Matcher) Match(stopId string, departure dvb.Departure) (*Match, error) {
+	stop := m.feed.StopByAPIId(stopId)
+	if stop == nil {
+		stop = m.feed.Stops[stopId]
+	}
+	if stop == nil {
+		return nil, ErrNoMatch
+	}
+
+	scheduled := departure.ScheduledTime.Time
+	if scheduled.IsZero() {
+		return nil, errors.New("departure has no scheduled time")
+	}
+
+	var best *Match
+	bestScore := time.Duration(-1)
+	for _, stopTime := range m.feed.StopTimesAt(stop.Id) {
+		trip := m.feed.Trips[stopTime.TripId]
+		if trip == nil {
+			continue
+		}
+		route := m.feed.Routes[trip.RouteId]
+		if route == nil || route.ShortName != departure.LineName {
+			continue
+		}
+
+		// Trips after midnight may belong to the previous service day
+		for _, offset := range []int{0, -1} {
+			day := m.feed.ServiceDay(scheduled.AddDate(0, 0, offset))
+			diff := day.Add(stopTime.Departure).Sub(scheduled).Abs()
+			if diff > m.Tolerance || !m.feed.ServiceActive(trip.ServiceId, day.In(m.feed.Location)) {
+				continue
+			}
+
+			match := &Match{Trip: trip, Route: route, StopTime: stopTime, ServiceDay: day}
+
+			// Prefer trips whose headsign matches the direction by weighting mismatches
+			score := diff
+			if !sameDirection(match.Headsign(), departure.Direction) {
+				score += m.Tolerance + time.Second
+			}
+			if best == nil || score < bestScore {
+				best, bestScore = match, score
+			}
+		}
+	}
+
+	if best == nil {
+		return nil, ErrNoMatch
+	}
+	return best, nil
+}
+
+// sameDirection compares a GTFS headsign with the API direction, which is often abbreviated
+func sameDirection(headsign, direction string) bool {
+	headsign = strings.ToLower(headsign)
+	direction = strings.ToLower(direction)
+	return headsign == direction || strings.Contains(headsign, direction) || strings.Contains(direction, headsign)
+}
@@ -0,0 +1,82 @@
+package gtfs
+
+import "sort"
+
+// Neighbor describes the stops adjacent to a station on one line and direction
+type Neighbor struct {
+	// Line is the name of the line (e.g. "11")
+	Line string
+
+	// Direction is the headsign of the trips running in this direction
+	Direction string
+
+	// Previous is the station served before, or nil at the start of the line
+	Previous *Stop
+
+	// Next is the station served after, or nil at the end of the line
+	Next *Stop
+}
+
+// Neighbors returns the directly connected stations of the given stop per line and
+// direction, derived from the stop sequences of all trips. The stop may be given as
+// DVB API stop ID or GTFS stop ID. Variants of the same line (e.g. short turns) with
+// different neighbors are returned as separate entries.
+func (f *Feed) Neighbors(stopId string) []Neighbor {
+	station := stopId
+	if stop := f.StopByAPIId(stopId); stop != nil {
+		station = stop.Id
+	}
+	station = f.stationOf(station)
+
+	seen := make(map[[4]string]bool)
+	var neighbors []Neighbor
+	for _, platform := range f.Platforms(station) {
+		for _, stopTime := range f.stopTimesByStop[platform.Id] {
+			trip := f.Trips[stopTime.TripId]
+			if trip == nil {
+				continue
+			}
+
+			neighbor := Neighbor{Line: trip.RouteId, Direction: trip.Headsign}
+			if route := f.Routes[trip.RouteId]; route != nil && route.ShortName != "" {
+				neighbor.Line = route.ShortName
+			}
+
+			stopTimes := f.StopTimes[trip.Id]
+			for i := range stopTimes {
+				if stopTimes[i].Sequence != stopTime.Sequence {
+					continue
+				}
+				if i > 0 {
+					neighbor.Previous = f.Stops[f.stationOf(stopTimes[i-1].StopId)]
+				}
+				if i+1 < len(stopTimes) {
+					neighbor.Next = f.Stops[f.stationOf(stopTimes[i+1].StopId)]
+				}
+				break
+			}
+
+			key := [4]string{neighbor.Line, neighbor.Direction, stopIdOf(neighbor.Previous), stopIdOf(neighbor.Next)}
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			neighbors = append(neighbors, neighbor)
+		}
+	}
+
+	sort.Slice(neighbors, func(i, j int) bool {
+		if neighbors[i].Line != neighbors[j].Line {
+			return neighbors[i].Line < neighbors[j].Line
+		}
+		return neighbors[i].Direction < neighbors[j].Direction
+	})
+	return neighbors
+}
+
+func stopIdOf(stop *Stop) string {
+	if stop == nil {
+		return ""
+	}
+	return stop.Id
+}
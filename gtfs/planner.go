@@ -0,0 +1,256 @@
+package gtfs
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/niclaszll/dvb-go"
+)
+
+// planningHorizon limits how far after the requested departure connections are considered
+const planningHorizon = 6 * time.Hour
+
+// connection is a vehicle moving between two consecutive stops of a trip
+type connection struct {
+	trip      *Trip
+	from, to  int // indexes into the trip's stop times
+	departure time.Time
+	arrival   time.Time
+}
+
+func (c *connection) fromStop(f *Feed) string { return f.StopTimes[c.trip.Id][c.from].StopId }
+func (c *connection) toStop(f *Feed) string   { return f.StopTimes[c.trip.Id][c.to].StopId }
+
+// Planner is an offline journey planner implementing the Connection Scan Algorithm
+// over the static feed. Results use the same model as dvb.Client.GetRoute, so they
+// can be shown in place of or compared against API results.
+type Planner struct {
+	feed *Feed
+
+	// TransferTime is the minimum time needed to change vehicles, including
+	// walking between platforms of the same station (defaults to two minutes)
+	TransferTime time.Duration
+
+	mu          sync.Mutex
+	connections map[time.Time][]connection
+}
+
+// NewPlanner creates a Planner for the given feed
+func NewPlanner(feed *Feed) *Planner {
+	return &Planner{
+		feed:         feed,
+		TransferTime: 2 * time.Minute,
+		connections:  make(map[time.Time][]connection),
+	}
+}
+
+// Plan finds the journey with the earliest arrival from origin to destination,
+// departing at or after departure. Origin and destination are DVB API stop IDs
+// or GTFS stop IDs. Returns a *dvb.NotFoundError wrapping dvb.ErrNoRoute if the
+// destination can't be reached within six hours.
+func (p *Planner) Plan(origin, destination string, departure time.Time) (*dvb.GetRouteResponse, error) {
+	notFound := &dvb.NotFoundError{Err: dvb.ErrNoRoute, Query: origin + " → " + destination}
+
+	originStop, destinationStop := p.stop(origin), p.stop(destination)
+	if originStop == nil || destinationStop == nil {
+		return nil, notFound
+	}
+
+	// ready is the earliest time a vehicle can be boarded at a platform,
+	// readyVia the platform whose arrival made it ready ("" for the origin)
+	ready := make(map[string]time.Time)
+	readyVia := make(map[string]string)
+	arrivedBy := make(map[string][2]*connection)
+	boarded := make(map[string]*connection)
+
+	for _, platform := range p.feed.Platforms(originStop.Id) {
+		ready[platform.Id] = departure
+		readyVia[platform.Id] = ""
+	}
+	targets := make(map[string]bool)
+	for _, platform := range p.feed.Platforms(destinationStop.Id) {
+		targets[platform.Id] = true
+	}
+
+	var best string
+	bestArrival := departure.Add(planningHorizon)
+	for _, c := range p.connectionsFrom(departure) {
+		if !c.departure.Before(bestArrival) {
+			break
+		}
+
+		if boarded[c.trip.Id] == nil {
+			at, ok := ready[c.fromStop(p.feed)]
+			if !ok || at.After(c.departure) {
+				continue
+			}
+			boarded[c.trip.Id] = c
+		}
+
+		to := c.toStop(p.feed)
+		if previous, ok := arrivedBy[to]; ok && !c.arrival.Before(previous[1].arrival) {
+			continue
+		}
+		arrivedBy[to] = [2]*connection{boarded[c.trip.Id], c}
+
+		if targets[to] && c.arrival.Before(bestArrival) {
+			best, bestArrival = to, c.arrival
+		}
+		for _, platform := range p.feed.Platforms(p.feed.stationOf(to)) {
+			at := c.arrival.Add(p.TransferTime)
+			if current, ok := ready[platform.Id]; !ok || at.Before(current) {
+				ready[platform.Id] = at
+				readyVia[platform.Id] = to
+			}
+		}
+	}
+
+	if best == "" {
+		return nil, notFound
+	}
+
+	return &dvb.GetRouteResponse{
+		Status: dvb.Status{Code: "Ok"},
+		Routes: []dvb.Route{p.route(best, arrivedBy, readyVia)},
+	}, nil
+}
+
+// route reconstructs the journey arriving at the given platform
+func (p *Planner) route(platform string, arrivedBy map[string][2]*connection, readyVia map[string]string) dvb.Route {
+	var partials []dvb.PartialRoute
+	for {
+		leg := arrivedBy[platform]
+		partials = append([]dvb.PartialRoute{p.transitLeg(leg[0], leg[1])}, partials...)
+
+		boarding := leg[0].fromStop(p.feed)
+		previous := readyVia[boarding]
+		if previous == "" {
+			break
+		}
+		if previous != boarding {
+			partials = append([]dvb.PartialRoute{{
+				Duration: int(p.TransferTime.Minutes()),
+				Mot:      dvb.Mot{Type: dvb.MotFootpath},
+			}}, partials...)
+		}
+		platform = previous
+	}
+
+	route := dvb.Route{PartialRoutes: partials, Synthesized: true}
+	route.Duration = int(math.Ceil(route.ArrivalTime().Sub(route.DepartureTime()).Minutes()))
+	for _, partial := range partials {
+		if partial.Mot.Type == dvb.MotFootpath {
+			continue
+		}
+		route.MotChain = append(route.MotChain, dvb.MotChain{
+			Type:      partial.Mot.Type,
+			Name:      *partial.Mot.Name,
+			Direction: *partial.Mot.Direction,
+		})
+	}
+	route.Interchanges = max(len(route.MotChain)-1, 0)
+	return route
+}
+
+// transitLeg converts a ride from the boarding to the alighting connection into a PartialRoute
+func (p *Planner) transitLeg(enter, exit *connection) dvb.PartialRoute {
+	trip := enter.trip
+	stopTimes := p.feed.StopTimes[trip.Id]
+	serviceDay := enter.departure.Add(-stopTimes[enter.from].Departure)
+
+	name, headsign := "", trip.Headsign
+	mot := dvb.Mot{Type: "", Name: &name, Direction: &headsign}
+	if route := p.feed.Routes[trip.RouteId]; route != nil {
+		name = route.ShortName
+		mot.Type = MotType(route.Type)
+	}
+
+	partial := dvb.PartialRoute{
+		Duration: int(exit.arrival.Sub(enter.departure).Minutes()),
+		Mot:      mot,
+	}
+	for _, stopTime := range stopTimes[enter.from : exit.to+1] {
+		stop := dvb.RegularStop{
+			ArrivalTime:   dvb.Time{Time: serviceDay.Add(stopTime.Arrival)},
+			DepartureTime: dvb.Time{Time: serviceDay.Add(stopTime.Departure)},
+			DhId:          stopTime.StopId,
+			Type:          "Stop",
+		}
+		if s := p.feed.Stops[stopTime.StopId]; s != nil {
+			stop.Name = s.Name
+			stop.Platform = dvb.Platform{Name: s.PlatformCode, Type: "Platform"}
+		}
+		if id, ok := StopIdFromDHID(p.feed.stationOf(stopTime.StopId)); ok {
+			stop.DataId = id
+		}
+		partial.RegularStops = append(partial.RegularStops, stop)
+	}
+	return partial
+}
+
+// stop resolves a DVB API or GTFS stop ID
+func (p *Planner) stop(id string) *Stop {
+	if stop := p.feed.StopByAPIId(id); stop != nil {
+		return stop
+	}
+	return p.feed.Stops[id]
+}
+
+// connectionsFrom returns the connections departing within the planning horizon,
+// ordered by departure. Trips of the previous service day are included as they
+// may still run after midnight.
+func (p *Planner) connectionsFrom(departure time.Time) []*connection {
+	end := departure.Add(planningHorizon)
+
+	var result []*connection
+	for _, offset := range []int{-1, 0, 1} {
+		day := p.feed.ServiceDay(departure.AddDate(0, 0, offset))
+		connections := p.connectionsOn(day)
+		start := sort.Search(len(connections), func(i int) bool {
+			return !connections[i].departure.Before(departure)
+		})
+		for i := start; i < len(connections) && connections[i].departure.Before(end); i++ {
+			result = append(result, &connections[i])
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].departure.Before(result[j].departure)
+	})
+	return result
+}
+
+// connectionsOn builds (and caches) all connections of the given service day, sorted by departure
+func (p *Planner) connectionsOn(day time.Time) []connection {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if connections, ok := p.connections[day]; ok {
+		return connections
+	}
+
+	var connections []connection
+	for _, trip := range p.feed.Trips {
+		if !p.feed.ServiceActive(trip.ServiceId, day.In(p.feed.Location)) {
+			continue
+		}
+		stopTimes := p.feed.StopTimes[trip.Id]
+		for i := 0; i+1 < len(stopTimes); i++ {
+			connections = append(connections, connection{
+				trip:      trip,
+				from:      i,
+				to:        i + 1,
+				departure: day.Add(stopTimes[i].Departure),
+				arrival:   day.Add(stopTimes[i+1].Arrival),
+			})
+		}
+	}
+	sort.Slice(connections, func(i, j int) bool {
+		return connections[i].departure.Before(connections[j].departure)
+	})
+
+	p.connections[day] = connections
+	return connections
+}
@@ -0,0 +1,115 @@
+package gtfs
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/niclaszll/dvb-go"
+)
+
+var _ dvb.ScheduleSource = (*Feed)(nil)
+
+// scheduleWindow limits how far ahead ScheduledDepartures looks if no limit is given
+const scheduleWindow = 2 * time.Hour
+
+// ScheduledDepartures computes departures at the stop with the given DVB API stop ID
+// from the feed's stop times and calendars, starting at from. It returns up to limit
+// departures, or all departures within the next two hours if limit is 0.
+//
+// Feed implements dvb.ScheduleSource, so it can be passed as Config.ScheduleFallback
+// to let MonitorStop degrade to scheduled data when the API is down.
+func (f *Feed) ScheduledDepartures(stopId string, from time.Time, limit int) (*dvb.MonitorStopResponse, error) {
+	stop := f.StopByAPIId(stopId)
+	if stop == nil {
+		stop = f.Stops[stopId]
+	}
+	if stop == nil {
+		return nil, errors.New("stop not in feed")
+	}
+
+	type candidate struct {
+		at       time.Time
+		stopTime *StopTime
+	}
+	var candidates []candidate
+
+	// Trips after midnight may belong to the previous service day
+	for _, offset := range []int{-1, 0} {
+		day := f.ServiceDay(from.AddDate(0, 0, offset))
+		for _, stopTime := range f.StopTimesAt(stop.Id) {
+			at := day.Add(stopTime.Departure)
+			if at.Before(from) || (limit == 0 && at.After(from.Add(scheduleWindow))) {
+				continue
+			}
+			trip := f.Trips[stopTime.TripId]
+			if trip == nil || f.isLastStop(stopTime) || !f.ServiceActive(trip.ServiceId, day.In(f.Location)) {
+				continue
+			}
+			candidates = append(candidates, candidate{at: at, stopTime: stopTime})
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].at.Before(candidates[j].at)
+	})
+	if limit > 0 && len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+
+	response := &dvb.MonitorStopResponse{
+		Name:   stop.Name,
+		Status: dvb.Status{Code: "Ok"},
+	}
+	for _, c := range candidates {
+		trip := f.Trips[c.stopTime.TripId]
+		route := f.Routes[trip.RouteId]
+
+		departure := dvb.Departure{
+			Id:            trip.Id,
+			Direction:     trip.Headsign,
+			ScheduledTime: dvb.Time{Time: c.at},
+		}
+		if c.stopTime.Headsign != "" {
+			departure.Direction = c.stopTime.Headsign
+		}
+		if route != nil {
+			departure.LineName = route.ShortName
+			departure.Mot = MotType(route.Type)
+		}
+		if platform := f.Stops[c.stopTime.StopId]; platform != nil && platform.PlatformCode != "" {
+			departure.Platform = dvb.Platform{Name: platform.PlatformCode, Type: "Platform"}
+		}
+		response.Departures = append(response.Departures, departure)
+	}
+
+	return response, nil
+}
+
+// isLastStop reports whether stopTime is the final stop of its trip, where nothing departs
+func (f *Feed) isLastStop(stopTime *StopTime) bool {
+	stopTimes := f.StopTimes[stopTime.TripId]
+	return len(stopTimes) > 0 && stopTimes[len(stopTimes)-1].Sequence == stopTime.Sequence
+}
+
+// MotType maps a GTFS route type to the mode of transport names used by the DVB API
+func MotType(routeType int) dvb.MotType {
+	switch {
+	case routeType == 0 || (routeType >= 900 && routeType < 1000):
+		return dvb.MotTram
+	case routeType == 1 || routeType == 2 || (routeType >= 100 && routeType < 200 && routeType != 109):
+		return dvb.MotTrain
+	case routeType == 109:
+		return dvb.MotSuburbanRailway
+	case routeType == 3 || (routeType >= 700 && routeType < 800):
+		return dvb.MotCityBus
+	case routeType == 200:
+		return dvb.MotIntercityBus
+	case routeType == 4 || routeType == 1000 || routeType == 1200:
+		return dvb.MotFerry
+	case routeType == 5 || routeType == 6 || routeType == 7 || routeType == 1300 || routeType == 1400:
+		return dvb.MotCableway
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,187 @@
+package gtfs
+
+import (
+	"archive/zip"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// WriteZip writes the feed as GTFS zip archive with agency.txt, stops.txt,
+// routes.txt, trips.txt, stop_times.txt and, if present, calendar.txt and
+// calendar_dates.txt. Records are sorted by ID, so equal feeds produce equal files.
+func (f *Feed) WriteZip(w io.Writer) error {
+	archive := zip.NewWriter(w)
+
+	files := []struct {
+		name   string
+		header []string
+		rows   func() [][]string
+		skip   bool
+	}{
+		{"agency.txt", []string{"agency_id", "agency_name", "agency_url", "agency_timezone"}, f.agencyRows, false},
+		{"stops.txt", []string{"stop_id", "stop_code", "stop_name", "stop_lat", "stop_lon", "location_type", "parent_station", "platform_code"}, f.stopRows, false},
+		{"routes.txt", []string{"route_id", "agency_id", "route_short_name", "route_long_name", "route_type"}, f.routeRows, false},
+		{"trips.txt", []string{"route_id", "service_id", "trip_id", "trip_headsign", "direction_id", "block_id", "shape_id"}, f.tripRows, false},
+		{"stop_times.txt", []string{"trip_id", "arrival_time", "departure_time", "stop_id", "stop_sequence", "stop_headsign"}, f.stopTimeRows, false},
+		{"calendar.txt", []string{"service_id", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday", "start_date", "end_date"}, f.calendarRows, len(f.Calendars) == 0},
+		{"calendar_dates.txt", []string{"service_id", "date", "exception_type"}, f.calendarDateRows, len(f.CalendarDates) == 0},
+	}
+
+	for _, file := range files {
+		if file.skip {
+			continue
+		}
+		out, err := archive.Create(file.name)
+		if err != nil {
+			return fmt.Errorf("failed to create %s: %w", file.name, err)
+		}
+		writer := csv.NewWriter(out)
+		writer.Write(file.header)
+		writer.WriteAll(file.rows())
+		if err := writer.Error(); err != nil {
+			return fmt.Errorf("failed to write %s: %w", file.name, err)
+		}
+	}
+
+	if err := archive.Close(); err != nil {
+		return fmt.Errorf("failed to write feed: %w", err)
+	}
+	return nil
+}
+
+// SaveFile writes the feed as GTFS zip archive to path, see WriteZip
+func (f *Feed) SaveFile(path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create feed: %w", err)
+	}
+	if err := f.WriteZip(file); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// sortedKeys returns the keys of m in ascending order
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (f *Feed) agencyRows() [][]string {
+	var rows [][]string
+	for _, id := range sortedKeys(f.Agencies) {
+		a := f.Agencies[id]
+		timezone := a.Timezone
+		if timezone == "" && f.Location != nil {
+			timezone = f.Location.String()
+		}
+		rows = append(rows, []string{a.Id, a.Name, a.URL, timezone})
+	}
+	return rows
+}
+
+func (f *Feed) stopRows() [][]string {
+	var rows [][]string
+	for _, id := range sortedKeys(f.Stops) {
+		s := f.Stops[id]
+		rows = append(rows, []string{
+			s.Id, s.Code, s.Name,
+			strconv.FormatFloat(s.Lat, 'f', 6, 64),
+			strconv.FormatFloat(s.Lon, 'f', 6, 64),
+			strconv.Itoa(s.LocationType), s.ParentStation, s.PlatformCode,
+		})
+	}
+	return rows
+}
+
+func (f *Feed) routeRows() [][]string {
+	var rows [][]string
+	for _, id := range sortedKeys(f.Routes) {
+		r := f.Routes[id]
+		rows = append(rows, []string{r.Id, r.AgencyId, r.ShortName, r.LongName, strconv.Itoa(r.Type)})
+	}
+	return rows
+}
+
+func (f *Feed) tripRows() [][]string {
+	var rows [][]string
+	for _, id := range sortedKeys(f.Trips) {
+		t := f.Trips[id]
+		rows = append(rows, []string{t.RouteId, t.ServiceId, t.Id, t.Headsign, strconv.Itoa(t.DirectionId), t.BlockId, t.ShapeId})
+	}
+	return rows
+}
+
+func (f *Feed) stopTimeRows() [][]string {
+	var rows [][]string
+	for _, tripId := range sortedKeys(f.StopTimes) {
+		stopTimes := append([]StopTime(nil), f.StopTimes[tripId]...)
+		sort.Slice(stopTimes, func(i, j int) bool {
+			return stopTimes[i].Sequence < stopTimes[j].Sequence
+		})
+		for _, st := range stopTimes {
+			arrival, departure := formatTime(st.Arrival), formatTime(st.Departure)
+			if st.Interpolated {
+				arrival, departure = "", ""
+			}
+			rows = append(rows, []string{
+				st.TripId, arrival, departure,
+				st.StopId, strconv.Itoa(st.Sequence), st.Headsign,
+			})
+		}
+	}
+	return rows
+}
+
+func (f *Feed) calendarRows() [][]string {
+	var rows [][]string
+	for _, id := range sortedKeys(f.Calendars) {
+		c := f.Calendars[id]
+		row := []string{c.ServiceId}
+		// calendar.txt starts with Monday, time.Weekday with Sunday
+		for _, day := range []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday, time.Sunday} {
+			row = append(row, formatBool(c.Weekdays[day]))
+		}
+		rows = append(rows, append(row, formatDate(c.StartDate), formatDate(c.EndDate)))
+	}
+	return rows
+}
+
+func (f *Feed) calendarDateRows() [][]string {
+	var rows [][]string
+	for _, id := range sortedKeys(f.CalendarDates) {
+		for _, d := range f.CalendarDates[id] {
+			rows = append(rows, []string{d.ServiceId, formatDate(d.Date), strconv.Itoa(d.ExceptionType)})
+		}
+	}
+	return rows
+}
+
+// formatTime formats an offset from the start of the service day as HH:MM:SS,
+// with hours exceeding 24 for times past midnight
+func formatTime(d time.Duration) string {
+	seconds := int(d / time.Second)
+	return fmt.Sprintf("%02d:%02d:%02d", seconds/3600, seconds/60%60, seconds%60)
+}
+
+// formatDate formats a date as YYYYMMDD
+func formatDate(t time.Time) string {
+	return t.Format("20060102")
+}
+
+func formatBool(b bool) string {
+	if b {
+		return "1"
+	}
+	return "0"
+}
@@ -0,0 +1,288 @@
+// Package gtfsrt publishes DVB real-time data as GTFS-Realtime TripUpdates feed,
+// so existing GTFS-RT consumers such as OpenTripPlanner can use it. The generator
+// polls MonitorStop for a set of stops, links the departures to the trips of a
+// static GTFS feed and serves the resulting protobuf feed over HTTP.
+//
+// Example usage:
+//
+//	feed, err := gtfs.LoadFile("vvo.zip")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	generator, err := gtfsrt.New(gtfsrt.Config{
+//		Client: dvb.NewClient(dvb.Config{}),
+//		Feed:   feed,
+//		Stops:  []string{"33000028", "33000037"},
+//	})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	generator.Start(ctx)
+//	defer generator.Stop()
+//	http.Handle("/gtfs-rt/trip-updates", generator)
+package gtfsrt
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/niclaszll/dvb-go"
+	"github.com/niclaszll/dvb-go/gtfs"
+)
+
+// Config configures the generator
+type Config struct {
+	// Client queries the departures
+	Client dvb.API
+
+	// Feed is the static GTFS feed the departures are linked to
+	Feed *gtfs.Feed
+
+	// Stops are the DVB API stop IDs to poll
+	Stops []string
+
+	// Interval is the time between two polls of all stops (optional, defaults to 30s)
+	Interval time.Duration
+
+	// Limit is the number of departures requested per stop (optional, defaults to 30)
+	Limit int
+
+	// Errors receives polling errors of individual stops (optional)
+	Errors func(stopId string, err error)
+}
+
+// TripUpdate is the real-time state of a single trip
+type TripUpdate struct {
+	TripId      string
+	RouteId     string
+	DirectionId int
+
+	// StartDate is the service day of the trip as YYYYMMDD
+	StartDate string
+
+	StopTimeUpdates []StopTimeUpdate
+
+	// Timestamp is the time the trip's data was last polled
+	Timestamp time.Time
+}
+
+// StopTimeUpdate is the predicted departure of a trip at a stop
+type StopTimeUpdate struct {
+	StopSequence int
+
+	// StopId is the GTFS stop ID
+	StopId string
+
+	// Delay is the difference between the predicted and the scheduled time
+	Delay time.Duration
+
+	// Time is the predicted departure time
+	Time time.Time
+
+	// Skipped is set if the departure is cancelled
+	Skipped bool
+}
+
+// Generator polls departures and maintains the TripUpdates feed. It implements
+// http.Handler, serving the latest feed as protobuf.
+type Generator struct {
+	config  Config
+	matcher *gtfs.Matcher
+
+	mu      sync.Mutex
+	feed    []byte
+	updates []*TripUpdate
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+// New creates a Generator. Call Start to begin polling or Update to poll once.
+func New(config Config) (*Generator, error) {
+	if config.Client == nil {
+		return nil, errors.New("client can not be nil")
+	}
+	if config.Feed == nil {
+		return nil, errors.New("feed can not be nil")
+	}
+	if len(config.Stops) == 0 {
+		return nil, errors.New("stops can not be empty")
+	}
+	if config.Interval <= 0 {
+		config.Interval = 30 * time.Second
+	}
+	if config.Limit <= 0 {
+		config.Limit = 30
+	}
+
+	g := &Generator{config: config, matcher: gtfs.NewMatcher(config.Feed)}
+	g.feed = encodeFeed(time.Now().Unix(), nil)
+	return g, nil
+}
+
+// Start begins polling in the background until Stop is called or ctx is cancelled.
+// A generator can only be started once.
+func (g *Generator) Start(ctx context.Context) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.done != nil {
+		return errors.New("generator already started")
+	}
+
+	ctx, g.cancel = context.WithCancel(ctx)
+	g.done = make(chan struct{})
+	go g.run(ctx, g.done)
+	return nil
+}
+
+// Stop ends polling and waits for the background goroutine to exit
+func (g *Generator) Stop() {
+	g.mu.Lock()
+	cancel, done := g.cancel, g.done
+	g.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+		<-done
+	}
+}
+
+func (g *Generator) run(ctx context.Context, done chan struct{}) {
+	defer close(done)
+
+	ticker := time.NewTicker(g.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		g.Update(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Update polls all stops once and replaces the feed. Stops that fail are reported
+// to Config.Errors and left out; an error is only returned if all stops failed.
+func (g *Generator) Update(ctx context.Context) error {
+	now := time.Now()
+	trips := make(map[string]*TripUpdate)
+
+	var failed int
+	var lastErr error
+	for _, stopId := range g.config.Stops {
+		response, err := g.config.Client.MonitorStop(ctx, &dvb.MonitorStopParams{
+			StopId:           stopId,
+			Limit:            dvb.Int(g.config.Limit),
+			ShortTermChanges: dvb.Bool(true),
+		})
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err != nil && !errors.Is(err, dvb.ErrNoDepartures) {
+			failed++
+			lastErr = err
+			if g.config.Errors != nil {
+				g.config.Errors(stopId, err)
+			}
+			continue
+		}
+		if response == nil {
+			continue
+		}
+		for _, departure := range response.Departures {
+			g.add(trips, stopId, departure, now)
+		}
+	}
+	if failed == len(g.config.Stops) {
+		return lastErr
+	}
+
+	updates := make([]*TripUpdate, 0, len(trips))
+	for _, update := range trips {
+		sort.Slice(update.StopTimeUpdates, func(i, j int) bool {
+			return update.StopTimeUpdates[i].StopSequence < update.StopTimeUpdates[j].StopSequence
+		})
+		updates = append(updates, update)
+	}
+	sort.Slice(updates, func(i, j int) bool {
+		if updates[i].StartDate != updates[j].StartDate {
+			return updates[i].StartDate < updates[j].StartDate
+		}
+		return updates[i].TripId < updates[j].TripId
+	})
+
+	feed := encodeFeed(now.Unix(), updates)
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.feed, g.updates = feed, updates
+	return nil
+}
+
+// add records the departure as stop time update of its trip. Departures without
+// real-time data or matching trip are ignored.
+func (g *Generator) add(trips map[string]*TripUpdate, stopId string, departure dvb.Departure, now time.Time) {
+	cancelled := departure.IsCancelled()
+	if departure.RealTime.IsZero() && !cancelled {
+		return
+	}
+
+	match, err := g.matcher.Match(stopId, departure)
+	if err != nil {
+		return
+	}
+
+	startDate := match.ServiceDay.Format("20060102")
+	key := match.Trip.Id + "|" + startDate
+	update, ok := trips[key]
+	if !ok {
+		update = &TripUpdate{
+			TripId:      match.Trip.Id,
+			RouteId:     match.Trip.RouteId,
+			DirectionId: match.Trip.DirectionId,
+			StartDate:   startDate,
+			Timestamp:   now,
+		}
+		trips[key] = update
+	}
+
+	stopTime := StopTimeUpdate{
+		StopSequence: match.StopTime.Sequence,
+		StopId:       match.StopTime.StopId,
+		Skipped:      cancelled,
+	}
+	if !cancelled {
+		stopTime.Time = departure.RealTime.Time
+		stopTime.Delay = departure.RealTime.Sub(match.ServiceDay.Add(match.StopTime.Departure))
+	}
+	update.StopTimeUpdates = append(update.StopTimeUpdates, stopTime)
+}
+
+// TripUpdates returns the trip updates of the latest feed
+func (g *Generator) TripUpdates() []*TripUpdate {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.updates
+}
+
+// Feed returns the latest feed as encoded FeedMessage protobuf
+func (g *Generator) Feed() []byte {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.feed
+}
+
+// ServeHTTP serves the latest feed as protobuf
+func (g *Generator) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	feed := g.Feed()
+	w.Header().Set("Content-Type", "application/x-protobuf")
+	w.Header().Set("Content-Length", strconv.Itoa(len(feed)))
+	w.Write(feed)
+}
@@ -0,0 +1,115 @@
+package gtfsrt
+
+import (
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Field numbers and enum values of gtfs-realtime.proto used by the generator.
+// The messages are encoded by hand to avoid generated code for the few fields needed.
+const (
+	feedMessageHeader = 1
+	feedMessageEntity = 2
+
+	feedHeaderVersion        = 1
+	feedHeaderIncrementality = 2
+	feedHeaderTimestamp      = 3
+
+	feedEntityId         = 1
+	feedEntityTripUpdate = 3
+
+	tripUpdateTrip           = 1
+	tripUpdateStopTimeUpdate = 2
+	tripUpdateTimestamp      = 4
+
+	tripDescriptorTripId               = 1
+	tripDescriptorStartDate            = 3
+	tripDescriptorScheduleRelationship = 4
+	tripDescriptorRouteId              = 5
+	tripDescriptorDirectionId          = 6
+
+	stopTimeUpdateStopSequence         = 1
+	stopTimeUpdateArrival              = 2
+	stopTimeUpdateDeparture            = 3
+	stopTimeUpdateStopId               = 4
+	stopTimeUpdateScheduleRelationship = 5
+
+	stopTimeEventDelay = 1
+	stopTimeEventTime  = 2
+
+	incrementalityFullDataset = 0
+	stopTimeSkipped           = 1
+)
+
+func appendString(b []byte, field protowire.Number, value string) []byte {
+	b = protowire.AppendTag(b, field, protowire.BytesType)
+	return protowire.AppendString(b, value)
+}
+
+func appendVarint(b []byte, field protowire.Number, value uint64) []byte {
+	b = protowire.AppendTag(b, field, protowire.VarintType)
+	return protowire.AppendVarint(b, value)
+}
+
+// appendInt encodes an int32 or int64 field; negative values use two's complement as protobuf requires
+func appendInt(b []byte, field protowire.Number, value int64) []byte {
+	return appendVarint(b, field, uint64(value))
+}
+
+func appendMessage(b []byte, field protowire.Number, message []byte) []byte {
+	b = protowire.AppendTag(b, field, protowire.BytesType)
+	return protowire.AppendBytes(b, message)
+}
+
+// encodeFeed encodes a full-dataset FeedMessage with the given trip updates
+func encodeFeed(timestamp int64, updates []*TripUpdate) []byte {
+	var header []byte
+	header = appendString(header, feedHeaderVersion, "2.0")
+	header = appendVarint(header, feedHeaderIncrementality, incrementalityFullDataset)
+	header = appendVarint(header, feedHeaderTimestamp, uint64(timestamp))
+
+	var feed []byte
+	feed = appendMessage(feed, feedMessageHeader, header)
+	for _, update := range updates {
+		var entity []byte
+		entity = appendString(entity, feedEntityId, update.TripId+":"+update.StartDate)
+		entity = appendMessage(entity, feedEntityTripUpdate, encodeTripUpdate(update))
+		feed = appendMessage(feed, feedMessageEntity, entity)
+	}
+	return feed
+}
+
+func encodeTripUpdate(update *TripUpdate) []byte {
+	var trip []byte
+	trip = appendString(trip, tripDescriptorTripId, update.TripId)
+	trip = appendString(trip, tripDescriptorStartDate, update.StartDate)
+	trip = appendVarint(trip, tripDescriptorScheduleRelationship, 0)
+	if update.RouteId != "" {
+		trip = appendString(trip, tripDescriptorRouteId, update.RouteId)
+	}
+	trip = appendVarint(trip, tripDescriptorDirectionId, uint64(update.DirectionId))
+
+	var b []byte
+	b = appendMessage(b, tripUpdateTrip, trip)
+	for _, stop := range update.StopTimeUpdates {
+		b = appendMessage(b, tripUpdateStopTimeUpdate, encodeStopTimeUpdate(stop))
+	}
+	b = appendVarint(b, tripUpdateTimestamp, uint64(update.Timestamp.Unix()))
+	return b
+}
+
+func encodeStopTimeUpdate(stop StopTimeUpdate) []byte {
+	var b []byte
+	b = appendVarint(b, stopTimeUpdateStopSequence, uint64(stop.StopSequence))
+	if !stop.Skipped {
+		var event []byte
+		event = appendInt(event, stopTimeEventDelay, int64(stop.Delay.Seconds()))
+		event = appendInt(event, stopTimeEventTime, stop.Time.Unix())
+		b = appendMessage(b, stopTimeUpdateArrival, event)
+		b = appendMessage(b, stopTimeUpdateDeparture, event)
+	}
+	b = appendString(b, stopTimeUpdateStopId, stop.StopId)
+	if stop.Skipped {
+		b = appendVarint(b, stopTimeUpdateScheduleRelationship, stopTimeSkipped)
+	}
+	return b
+}
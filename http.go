@@ -4,10 +4,15 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type requestOptions struct {
@@ -18,23 +23,110 @@ type requestOptions struct {
 	Headers map[string]string
 }
 
-func (c *Client) doRequest(ctx context.Context, opts requestOptions) (*http.Response, error) {
-	u, err := url.Parse(c.baseURL)
+func (c *Client) doRequest(ctx context.Context, opts requestOptions) (resp *http.Response, err error) {
+	opts = opts.withSettings(ctx)
+	ctx, endSpan := c.startSpan(ctx, opts)
+	defer func() { endSpan(resp, err) }()
+
+	var bodyBytes []byte
+	if opts.Body != nil {
+		bodyBytes, err = json.Marshal(opts.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal request body: %w", err)
+		}
+	}
+
+	attempts := max(c.retryPolicy.MaxAttempts, 1)
+	for attempt := 1; ; attempt++ {
+		resp, err := c.tryEndpoints(ctx, opts, bodyBytes)
+		if attempt >= attempts || ctx.Err() != nil || !c.retryPolicy.retryable(resp, err) {
+			return resp, err
+		}
+
+		wait := c.retryPolicy.backoff(attempt, resp)
+		if resp != nil {
+			resp.Body.Close()
+		}
+		if err := sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+		c.metrics.Retry(opts.Path)
+		trace.SpanFromContext(ctx).AddEvent("retry", trace.WithAttributes(attribute.Int("dvb.attempt", attempt+1)))
+	}
+}
+
+// tryEndpoints sends the request to the configured endpoints in order of preference
+func (c *Client) tryEndpoints(ctx context.Context, opts requestOptions, bodyBytes []byte) (*http.Response, error) {
+	// Try the endpoints in order of preference, failing over to the next one
+	// on network errors and server-side failures
+	endpoints := c.endpoints.candidates()
+	for i, endpoint := range endpoints {
+		if i > 0 {
+			c.metrics.Retry(opts.Path)
+		}
+
+		if err := c.rateLimiter.wait(ctx); err != nil {
+			return nil, err
+		}
+
+		var release func()
+		if c.tenant != nil {
+			var err error
+			release, err = c.tenant.acquire(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		start := time.Now()
+		resp, err := c.send(ctx, endpoint.baseURL, opts, bodyBytes)
+		if release != nil {
+			holdSlot(resp, release)
+		}
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		}
+		c.metrics.RequestDone(opts.Path, statusCode, time.Since(start), err)
+
+		if ctx.Err() != nil {
+			return resp, err
+		}
+
+		failed := err != nil || resp.StatusCode >= http.StatusInternalServerError
+		if !failed {
+			endpoint.markHealthy()
+			return resp, nil
+		}
+
+		endpoint.markUnhealthy(c.endpoints.cooldown)
+		if i == len(endpoints)-1 {
+			return resp, err
+		}
+		if resp != nil {
+			resp.Body.Close()
+		}
+	}
+
+	return nil, errors.New("no endpoint configured")
+}
+
+// send builds and executes a single request against the given base URL
+func (c *Client) send(ctx context.Context, baseURL string, opts requestOptions, bodyBytes []byte) (*http.Response, error) {
+	u, err := url.Parse(baseURL)
 	if err != nil {
 		return nil, fmt.Errorf("invalid base URL: %w", err)
 	}
 
-	u.Path = opts.Path
+	// Join instead of overwriting so path prefixes in the base URL are kept,
+	// e.g. https://gateway.example.com/vvo for path-prefixed reverse proxies
+	u = u.JoinPath(opts.Path)
 	if opts.Query != nil {
-		u.RawQuery = opts.Query.Encode()
+		u.RawQuery = c.encoding.encode(opts.Query)
 	}
 
 	var body io.Reader
-	if opts.Body != nil {
-		bodyBytes, err := json.Marshal(opts.Body)
-		if err != nil {
-			return nil, fmt.Errorf("failed to marshal request body: %w", err)
-		}
+	if bodyBytes != nil {
 		body = bytes.NewReader(bodyBytes)
 	}
 
@@ -48,46 +140,103 @@ func (c *Client) doRequest(ctx context.Context, opts requestOptions) (*http.Resp
 		req.Header.Set("Content-Type", "application/json")
 	}
 
+	for key, value := range c.headers {
+		req.Header.Set(key, value)
+	}
+
+	if err := c.setAuthHeaders(ctx, req); err != nil {
+		return nil, err
+	}
+
 	for key, value := range opts.Headers {
 		req.Header.Set(key, value)
 	}
 
+	// Request compressed responses to save bandwidth, e.g. for large GetRoute
+	// payloads. Setting the header disables the transport's own decompression,
+	// so decompressResponse decodes the body instead.
+	if !c.disableCompression && req.Header.Get("Accept-Encoding") == "" {
+		req.Header.Set("Accept-Encoding", acceptEncoding)
+	}
+
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("request failed: %w", err)
 	}
 
+	if !c.disableCompression {
+		decompressResponse(resp)
+	}
 	return resp, nil
 }
 
-// Process the HTTP response and unmarshal JSON into the target
-func (c *Client) handleResponse(resp *http.Response, target interface{}) error {
-	defer resp.Body.Close()
-
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return c.handleErrorResponse(resp)
+// Apply the configured authentication headers to the request
+func (c *Client) setAuthHeaders(ctx context.Context, req *http.Request) error {
+	token := c.bearerToken
+	if c.tokenSource != nil {
+		var err error
+		token, err = c.tokenSource(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to obtain token: %w", err)
+		}
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
 	}
 
-	if target == nil {
-		return nil
+	if c.apiKey != "" {
+		req.Header.Set(c.apiKeyHeader, c.apiKey)
 	}
 
-	body, err := io.ReadAll(resp.Body)
+	return nil
+}
+
+// Process the HTTP response and unmarshal JSON into the target
+func (c *Client) handleResponse(resp *http.Response, target interface{}) error {
+	body, err := c.readResponse(resp)
 	if err != nil {
-		return fmt.Errorf("failed to read response body: %w", err)
+		return err
 	}
 
-	if len(body) == 0 {
+	if target == nil || len(body) == 0 {
 		return nil
 	}
 
+	var query url.Values
+	if resp.Request != nil {
+		query = resp.Request.URL.Query()
+	}
+	return decodeResponse(body, query, target)
+}
+
+// decodeResponse unmarshals the response body into target. Failures reported in
+// the response's Status are returned as errors, see statusError.
+func decodeResponse(body []byte, query url.Values, target interface{}) error {
 	if err := json.Unmarshal(body, target); err != nil {
 		return fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
+	if response, ok := target.(statusResponse); ok {
+		return statusError(response.apiStatus(), query)
+	}
 	return nil
 }
 
+// readResponse returns the body of a successful response, or the API error
+func (c *Client) readResponse(resp *http.Response) ([]byte, error) {
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, c.handleErrorResponse(resp)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	return body, nil
+}
+
 // Process error responses from the API
 func (c *Client) handleErrorResponse(resp *http.Response) error {
 	body, err := io.ReadAll(resp.Body)
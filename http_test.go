@@ -0,0 +1,71 @@
+package dvb
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestSendKeepsBaseURLPathPrefix(t *testing.T) {
+	tests := []struct {
+		name     string
+		prefix   string
+		encoding EncodingOptions
+		rawQuery string
+	}{
+		{
+			name:     "without trailing slash",
+			prefix:   "/vvo",
+			rawQuery: "limit=5&stopid=33000028&time=2025-01-01T12%3A00%3A00%2B01%3A00",
+		},
+		{
+			name:     "with trailing slash",
+			prefix:   "/vvo/",
+			rawQuery: "limit=5&stopid=33000028&time=2025-01-01T12%3A00%3A00%2B01%3A00",
+		},
+		{
+			name:     "custom encoding",
+			prefix:   "/vvo",
+			encoding: EncodingOptions{Separator: ";", Unescaped: ":"},
+			rawQuery: "limit=5;stopid=33000028;time=2025-01-01T12:00:00%2B01:00",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var path, rawQuery string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				path, rawQuery = r.URL.Path, r.URL.RawQuery
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{"Status":{"Code":"Ok"}}`))
+			}))
+			defer server.Close()
+
+			client := NewClient(Config{BaseURL: server.URL + tt.prefix, Encoding: tt.encoding})
+			opts := requestOptions{
+				Method: http.MethodGet,
+				Path:   "/dm",
+				Query: url.Values{
+					"stopid": {"33000028"},
+					"limit":  {"5"},
+					"time":   {"2025-01-01T12:00:00+01:00"},
+				},
+			}
+
+			resp, err := client.send(context.Background(), server.URL+tt.prefix, opts, nil)
+			if err != nil {
+				t.Fatalf("send: %v", err)
+			}
+			resp.Body.Close()
+
+			if path != "/vvo/dm" {
+				t.Errorf("path = %q, want %q", path, "/vvo/dm")
+			}
+			if rawQuery != tt.rawQuery {
+				t.Errorf("query = %q, want %q", rawQuery, tt.rawQuery)
+			}
+		})
+	}
+}
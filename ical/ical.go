@@ -0,0 +1,211 @@
+// Package ical exports planned journeys as iCalendar (RFC 5545) so they can be
+// added to calendar apps. Every leg of a route becomes an event with the boarding
+// stop as location, the platforms in the description and an optional alarm.
+//
+// Example usage:
+//
+//	response, err := client.GetRoute(ctx, params)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	file, err := os.Create("journey.ics")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	defer file.Close()
+//	err = ical.Write(file, ical.Options{Alarm: 10 * time.Minute}, &response.Routes[0])
+package ical
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/niclaszll/dvb-go"
+)
+
+// Options configures the exported calendar
+type Options struct {
+	// Alarm adds a reminder this long before each leg's departure (optional, no alarm if 0)
+	Alarm time.Duration
+
+	// ProdId identifies the product creating the calendar (optional, defaults to "-//dvb-go//ical//EN")
+	ProdId string
+}
+
+// Event is a single leg of a journey
+type Event struct {
+	// UID identifies the event; it is derived from the leg, so exporting the same journey twice yields the same UIDs
+	UID string
+
+	// Summary is the event title, e.g. "Tram 3 → Wilder Mann"
+	Summary string
+
+	// Location is the boarding stop, e.g. "Hauptbahnhof, Dresden (Platform 3)"
+	Location string
+
+	// Description lists boarding and alighting stop with their platforms
+	Description string
+
+	// Start and End are the departure at the boarding and the arrival at the alighting stop
+	Start time.Time
+	End   time.Time
+
+	// Latitude and Longitude are the WGS84 position of the boarding stop, only valid if HasGeo is set
+	Latitude  float64
+	Longitude float64
+	HasGeo    bool
+}
+
+// Events converts the legs of the route into events. Real-time data is preferred
+// over scheduled times. Legs without stops or times are skipped.
+func Events(route *dvb.Route) []Event {
+	var events []Event
+	for leg := range route.Legs() {
+		boarding, alighting := leg.BoardingStop(), leg.AlightingStop()
+		if boarding == nil {
+			continue
+		}
+
+		start := firstTime(boarding.DepartureRealTime, &boarding.DepartureTime)
+		end := firstTime(alighting.ArrivalRealTime, &alighting.ArrivalTime)
+		if start.IsZero() {
+			continue
+		}
+		if end.Before(start) {
+			end = start
+		}
+
+		event := Event{
+			Summary:     summary(leg),
+			Location:    stopName(boarding),
+			Description: fmt.Sprintf("From: %s\nTo: %s", stopName(boarding), stopName(alighting)),
+			Start:       start,
+			End:         end,
+		}
+		if leg.Mot.Direction != nil && *leg.Mot.Direction != "" {
+			event.Description += "\nDirection: " + *leg.Mot.Direction
+		}
+		event.Latitude, event.Longitude, event.HasGeo = boarding.WGS84()
+
+		sum := sha1.Sum([]byte(event.Summary + "|" + boarding.DataId + "|" + strconv.FormatInt(boarding.DepartureTime.Unix(), 10)))
+		event.UID = hex.EncodeToString(sum[:10]) + "@dvb-go"
+
+		events = append(events, event)
+	}
+	return events
+}
+
+// Write writes a calendar containing the events of all given routes to w
+func Write(w io.Writer, options Options, routes ...*dvb.Route) error {
+	if options.ProdId == "" {
+		options.ProdId = "-//dvb-go//ical//EN"
+	}
+
+	buf := bufio.NewWriter(w)
+	line := func(name, value string) {
+		writeFolded(buf, name+":"+value)
+	}
+
+	stamp := formatTime(time.Now())
+	line("BEGIN", "VCALENDAR")
+	line("VERSION", "2.0")
+	line("PRODID", escape(options.ProdId))
+	line("CALSCALE", "GREGORIAN")
+	for _, route := range routes {
+		for _, event := range Events(route) {
+			line("BEGIN", "VEVENT")
+			line("UID", event.UID)
+			line("DTSTAMP", stamp)
+			line("DTSTART", formatTime(event.Start))
+			line("DTEND", formatTime(event.End))
+			line("SUMMARY", escape(event.Summary))
+			line("LOCATION", escape(event.Location))
+			line("DESCRIPTION", escape(event.Description))
+			if event.HasGeo {
+				line("GEO", fmt.Sprintf("%.6f;%.6f", event.Latitude, event.Longitude))
+			}
+			if options.Alarm > 0 {
+				line("BEGIN", "VALARM")
+				line("ACTION", "DISPLAY")
+				line("DESCRIPTION", escape(event.Summary))
+				line("TRIGGER", fmt.Sprintf("-PT%dM", int(options.Alarm.Minutes())))
+				line("END", "VALARM")
+			}
+			line("END", "VEVENT")
+		}
+	}
+	line("END", "VCALENDAR")
+
+	if err := buf.Flush(); err != nil {
+		return fmt.Errorf("failed to write calendar: %w", err)
+	}
+	return nil
+}
+
+// summary describes a leg, e.g. "Tram 3 → Wilder Mann" or "Footpath"
+func summary(leg *dvb.PartialRoute) string {
+	name := leg.Mot.Type.String()
+	if leg.Mot.Name != nil && *leg.Mot.Name != "" {
+		name += " " + *leg.Mot.Name
+	}
+	if leg.Mot.Direction != nil && *leg.Mot.Direction != "" {
+		name += " → " + *leg.Mot.Direction
+	}
+	return name
+}
+
+// stopName describes a stop including place and platform
+func stopName(stop *dvb.RegularStop) string {
+	name := stop.Name
+	if stop.Place != "" {
+		name += ", " + stop.Place
+	}
+	if stop.Platform.Name != "" {
+		name += " (Platform " + stop.Platform.Name + ")"
+	}
+	return name
+}
+
+// firstTime returns the first known time of the candidates
+func firstTime(candidates ...*dvb.Time) time.Time {
+	for _, candidate := range candidates {
+		if candidate != nil && !candidate.IsZero() {
+			return candidate.Time
+		}
+	}
+	return time.Time{}
+}
+
+// formatTime formats t as UTC date-time
+func formatTime(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
+
+// escape escapes a TEXT value
+func escape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`).Replace(s)
+}
+
+// writeFolded writes a content line, folding it after 75 octets without
+// splitting UTF-8 sequences
+func writeFolded(w *bufio.Writer, s string) {
+	limit := 75
+	for len(s) > limit {
+		cut := limit
+		for cut > 0 && s[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		w.WriteString(s[:cut])
+		w.WriteString("\r\n ")
+		s = s[cut:]
+		limit = 74
+	}
+	w.WriteString(s)
+	w.WriteString("\r\n")
+}
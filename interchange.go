@@ -0,0 +1,145 @@
+package dvb
+
+// Interchange describes a transfer between two consecutive transit segments of a route.
+type Interchange struct {
+	// From is the stop (including platform) where the previous transit segment ends
+	From RegularStop
+
+	// To is the stop (including platform) where the next transit segment starts
+	To RegularStop
+
+	// WalkDuration is the total walking time in minutes between both platforms
+	WalkDuration int
+
+	// Buffer is the slack in minutes left after walking, based on real-time data
+	// where available. A negative value means the connection can't be reached as planned.
+	Buffer int
+
+	// Flagged is true if the API flagged the changeover as endangered
+	Flagged bool
+
+	// Endangered is true if the API flagged the changeover as endangered
+	// or the computed buffer is negative
+	Endangered bool
+}
+
+// TransferRisk classifies why a transfer is at risk
+type TransferRisk string
+
+const (
+	TransferMissed  TransferRisk = "missed"  // The buffer is negative, the connection can't be reached as planned
+	TransferFlagged TransferRisk = "flagged" // The API flagged the changeover as endangered
+	TransferTight   TransferRisk = "tight"   // The buffer is below the requested margin
+)
+
+// TransferWarning describes a risky transfer of a route
+type TransferWarning struct {
+	// Index is the position of the transfer in InterchangeDetails
+	Index int
+
+	// Interchange is the risky transfer
+	Interchange Interchange
+
+	// Slack is the buffer in minutes left after walking, see Interchange.Buffer
+	Slack int
+
+	// Risk is the most severe reason the transfer is at risk
+	Risk TransferRisk
+}
+
+// InterchangeDetails derives the interchanges between consecutive transit segments
+// of the route. Footpaths between two transit segments count towards the walking time;
+// waiting segments like StayForConnection don't, as the wait is already part of the
+// time between arrival and departure. Returns nil if the route has fewer than two transit segments.
+func (r *Route) InterchangeDetails() []Interchange {
+	var interchanges []Interchange
+
+	previous := -1
+	walk := 0
+	for i := range r.PartialRoutes {
+		partial := &r.PartialRoutes[i]
+		if !partial.isTransit() {
+			if partial.Mot.Type == MotFootpath {
+				walk += partial.Duration
+			}
+			continue
+		}
+
+		if previous >= 0 {
+			interchanges = append(interchanges, r.interchange(previous, i, walk))
+		}
+		previous = i
+		walk = 0
+	}
+
+	return interchanges
+}
+
+// TransferWarnings scans the route's transfers and returns a warning for every
+// transfer that is flagged as endangered by the API or leaves less than minSlack
+// minutes after walking, based on real-time data where available. Apps can use
+// it to highlight risky connections. Returns nil if all transfers are safe.
+//
+// Example usage:
+//
+//	for _, warning := range route.TransferWarnings(2) {
+//		fmt.Printf("%s → %s: %d min (%s)\n", warning.Interchange.From.Name,
+//			warning.Interchange.To.Name, warning.Slack, warning.Risk)
+//	}
+func (r *Route) TransferWarnings(minSlack int) []TransferWarning {
+	var warnings []TransferWarning
+	for i, interchange := range r.InterchangeDetails() {
+		var risk TransferRisk
+		switch {
+		case interchange.Buffer < 0:
+			risk = TransferMissed
+		case interchange.Flagged:
+			risk = TransferFlagged
+		case interchange.Buffer < minSlack:
+			risk = TransferTight
+		default:
+			continue
+		}
+		warnings = append(warnings, TransferWarning{
+			Index:       i,
+			Interchange: interchange,
+			Slack:       interchange.Buffer,
+			Risk:        risk,
+		})
+	}
+	return warnings
+}
+
+// interchange builds the Interchange between the transit segments at index from and to
+func (r *Route) interchange(from, to, walk int) Interchange {
+	arriving := r.PartialRoutes[from].AlightingStop()
+	departing := r.PartialRoutes[to].BoardingStop()
+
+	interchange := Interchange{
+		From:         *arriving,
+		To:           *departing,
+		WalkDuration: walk,
+	}
+
+	arrival := firstDate(arriving.ArrivalRealTime, &arriving.ArrivalTime)
+	departure := firstDate(departing.DepartureRealTime, &departing.DepartureTime)
+	if !arrival.IsZero() && !departure.IsZero() {
+		interchange.Buffer = int(departure.Sub(arrival).Minutes()) - walk
+	}
+
+	for _, partial := range r.PartialRoutes[from : to+1] {
+		if partial.ChangeoverEndangered != nil && *partial.ChangeoverEndangered {
+			interchange.Flagged = true
+		}
+	}
+	interchange.Endangered = interchange.Flagged || interchange.Buffer < 0
+
+	return interchange
+}
+
+// isTransit reports whether the segment is a ride on a public transport vehicle,
+// see MotType.IsTransit. Segments without stops are not considered transit, so
+// callers can rely on BoardingStop and AlightingStop being set.
+func (p *PartialRoute) isTransit() bool {
+	return p.Mot.Type.IsTransit() && len(p.RegularStops) > 0
+}
@@ -0,0 +1,214 @@
+package dvb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+)
+
+// LineInfo contains the details of a line known to a LineDirectory
+type LineInfo struct {
+	// Diva identifies the line
+	Diva Diva
+
+	// Name is the display name of the line (e.g., "11", "85", "S1")
+	Name string
+
+	// Mot indicates the mode of transport
+	Mot MotType
+
+	// Directions lists the destinations the line was seen travelling to, sorted
+	Directions []string
+
+	// Operator is the name of the transport company, empty until the line was seen in a route
+	Operator string
+
+	// OperatorCode is the code of the transport company, empty until the line was seen in a route
+	OperatorCode string
+
+	// ProductName describes the type of service (e.g., "Straßenbahn"), empty until
+	// the line was seen in a route
+	ProductName string
+
+	// StopIds lists the stops the line was seen at, sorted
+	StopIds []string
+}
+
+// LineDirectory resolves DIVA identifiers to line details. The API has no endpoint
+// for this, so the directory learns lines from GetLines, MonitorStop and GetRoute
+// responses added to it, e.g. by Discover for the stops of an area on startup.
+// A LineDirectory is safe for concurrent use.
+//
+// Example usage:
+//
+//	directory := dvb.NewLineDirectory()
+//	if err := directory.Discover(ctx, client, []string{"33000028", "33000037"}); err != nil {
+//		log.Printf("Some stops failed: %v", err)
+//	}
+//	if line, ok := directory.Lookup(departure.Diva); ok {
+//		fmt.Println(line.Name, line.Mot, line.Directions)
+//	}
+type LineDirectory struct {
+	mu    sync.Mutex
+	lines map[Diva]*LineInfo
+}
+
+// NewLineDirectory creates an empty LineDirectory
+func NewLineDirectory() *LineDirectory {
+	return &LineDirectory{lines: make(map[Diva]*LineInfo)}
+}
+
+// Lookup returns the details of the line identified by diva
+func (d *LineDirectory) Lookup(diva Diva) (LineInfo, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	line, ok := d.lines[diva]
+	if !ok {
+		return LineInfo{}, false
+	}
+	return line.clone(), true
+}
+
+// Lines returns all known lines ordered by name
+func (d *LineDirectory) Lines() []LineInfo {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	lines := make([]LineInfo, 0, len(d.lines))
+	for _, line := range d.lines {
+		lines = append(lines, line.clone())
+	}
+	sort.Slice(lines, func(i, j int) bool {
+		if lines[i].Name != lines[j].Name {
+			return lines[i].Name < lines[j].Name
+		}
+		return lines[i].Diva.Number < lines[j].Diva.Number
+	})
+	return lines
+}
+
+// AddLines records the lines of a GetLines response for the stop
+func (d *LineDirectory) AddLines(stopId string, response *GetLinesResponse) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, line := range response.Lines {
+		info := d.line(line.Diva, line.Name, line.Mot)
+		if info == nil {
+			continue
+		}
+		for _, direction := range line.Directions {
+			info.Directions = insertSorted(info.Directions, direction.Name)
+		}
+		info.StopIds = insertSorted(info.StopIds, stopId)
+	}
+}
+
+// AddDepartures records the lines of a MonitorStop response for the stop
+func (d *LineDirectory) AddDepartures(stopId string, response *MonitorStopResponse) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, departure := range response.Departures {
+		info := d.line(departure.Diva, departure.LineName, departure.Mot)
+		if info == nil {
+			continue
+		}
+		info.Directions = insertSorted(info.Directions, departure.Direction)
+		info.StopIds = insertSorted(info.StopIds, stopId)
+	}
+}
+
+// AddRoutes records the lines of a GetRoute response, including their operators
+func (d *LineDirectory) AddRoutes(response *GetRouteResponse) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, route := range response.Routes {
+		for _, chain := range route.MotChain {
+			info := d.line(chain.Diva, chain.Name, chain.Type)
+			if info == nil {
+				continue
+			}
+			info.Directions = insertSorted(info.Directions, chain.Direction)
+			if chain.TransportationCompany != "" {
+				info.Operator = chain.TransportationCompany
+			}
+			if chain.OperatorCode != "" {
+				info.OperatorCode = chain.OperatorCode
+			}
+			if chain.ProductName != "" {
+				info.ProductName = chain.ProductName
+			}
+		}
+	}
+}
+
+// Discover queries the lines of the stops concurrently and records them, see
+// AddLines. The lines of all successful stops are recorded; the returned error
+// joins the errors of the stops that failed.
+func (d *LineDirectory) Discover(ctx context.Context, client *Client, stopIds []string) error {
+	var errs []error
+	err := RunBulk(ctx, stopIds,
+		func(ctx context.Context, stopId string) (*GetLinesResponse, error) {
+			return client.GetLines(ctx, &GetLinesParams{StopId: stopId})
+		},
+		func(stopId string, response *GetLinesResponse, err error) error {
+			if err != nil {
+				errs = append(errs, fmt.Errorf("stop %s: %w", stopId, err))
+				return nil
+			}
+			d.AddLines(stopId, response)
+			return nil
+		},
+		BulkOptions{},
+	)
+	if err != nil {
+		return err
+	}
+	return errors.Join(errs...)
+}
+
+// line returns the entry of diva, creating it if necessary, and updates its name
+// and mode of transport. Returns nil for empty DIVA identifiers. Requires d.mu.
+func (d *LineDirectory) line(diva Diva, name string, mot MotType) *LineInfo {
+	if diva.Number == "" {
+		return nil
+	}
+
+	info, ok := d.lines[diva]
+	if !ok {
+		info = &LineInfo{Diva: diva}
+		d.lines[diva] = info
+	}
+	if name != "" {
+		info.Name = name
+	}
+	if mot != "" {
+		info.Mot = mot
+	}
+	return info
+}
+
+func (l *LineInfo) clone() LineInfo {
+	clone := *l
+	clone.Directions = slices.Clone(l.Directions)
+	clone.StopIds = slices.Clone(l.StopIds)
+	return clone
+}
+
+// insertSorted inserts value into the sorted slice unless it is empty or already contained
+func insertSorted(values []string, value string) []string {
+	if value == "" {
+		return values
+	}
+	i, found := slices.BinarySearch(values, value)
+	if found {
+		return values
+	}
+	return slices.Insert(values, i, value)
+}
@@ -0,0 +1,284 @@
+package dvb
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+)
+
+// LineMonitorParams contains the parameters for monitoring all stops of a line.
+type LineMonitorParams struct {
+	// Line is the line name as shown in departures (e.g. "11"). This is required and cannot be empty.
+	Line string
+
+	// Stops lists the stop IDs served by the line. This is required and cannot be empty.
+	// Use gtfs.Feed.LineStops to discover them from the static feed.
+	Stops []string
+
+	// Interval is the time between two polls of all stops (optional, defaults to 60s)
+	Interval time.Duration
+}
+
+// StopDelay is the state of a vehicle at one stop of its trip
+type StopDelay struct {
+	StopId    string
+	Scheduled time.Time
+	RealTime  time.Time
+
+	// Delay is RealTime minus Scheduled, or 0 if no real-time data is available
+	Delay time.Duration
+}
+
+// Vehicle is a single trip of the monitored line, identified by the departure ID
+type Vehicle struct {
+	Id        string
+	Line      string
+	Direction string
+
+	// Progression lists the vehicle's delay at each monitored stop, ordered by scheduled time
+	Progression []StopDelay
+
+	// LastSeen is when the vehicle last appeared in any departure board
+	LastSeen time.Time
+}
+
+// CurrentDelay returns the delay at the latest stop with real-time data
+func (v *Vehicle) CurrentDelay() time.Duration {
+	for i := len(v.Progression) - 1; i >= 0; i-- {
+		if !v.Progression[i].RealTime.IsZero() {
+			return v.Progression[i].Delay
+		}
+	}
+	return 0
+}
+
+// LineUpdate is delivered after every poll of all stops
+type LineUpdate struct {
+	// Vehicles contains all currently known vehicles of the line
+	Vehicles []Vehicle
+
+	// Departures contains the line's departures per stop ID
+	Departures map[string][]Departure
+
+	// Errors contains the errors per stop ID that failed during this poll
+	Errors map[string]error
+}
+
+// LineMonitor maintains live departures for all stops of a line and tracks each
+// vehicle's delay as it progresses along the line.
+type LineMonitor struct {
+	client *Client
+	params LineMonitorParams
+
+	mu       sync.Mutex
+	vehicles map[string]*Vehicle
+	cancel   context.CancelFunc
+	done     chan struct{}
+	updates  chan LineUpdate
+}
+
+// vehicleRetention is how long vehicles are kept after they disappeared from all boards
+const vehicleRetention = 30 * time.Minute
+
+// NewLineMonitor creates a LineMonitor for the given line. Call Start to begin polling.
+func (c *Client) NewLineMonitor(params LineMonitorParams) (*LineMonitor, error) {
+	if params.Line == "" {
+		return nil, errors.New("line can not be empty")
+	}
+	if len(params.Stops) == 0 {
+		return nil, errors.New("stops can not be empty")
+	}
+	if params.Interval <= 0 {
+		params.Interval = 60 * time.Second
+	}
+
+	return &LineMonitor{
+		client:   c,
+		params:   params,
+		vehicles: make(map[string]*Vehicle),
+		updates:  make(chan LineUpdate, 1),
+	}, nil
+}
+
+// Updates returns the channel receiving an update after every poll. It is closed
+// when the monitor stops; call Updates again after restarting the monitor. If the
+// consumer falls behind, older updates are dropped in favor of the latest one.
+func (m *LineMonitor) Updates() <-chan LineUpdate {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.updates
+}
+
+// Start begins polling in the background until Stop is called or ctx is cancelled.
+// A stopped monitor can be started again.
+func (m *LineMonitor) Start(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.cancel != nil {
+		return errors.New("monitor already running")
+	}
+
+	ctx, m.cancel = context.WithCancel(ctx)
+	m.done = make(chan struct{})
+	m.client.metrics.PollerState(m.name(), true)
+	go m.run(ctx, m.done, m.updates)
+	return nil
+}
+
+// Stop ends polling and waits for the background goroutine to exit
+func (m *LineMonitor) Stop() {
+	m.mu.Lock()
+	cancel, done := m.cancel, m.done
+	m.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+		<-done
+	}
+}
+
+// name identifies the monitor in metrics
+func (m *LineMonitor) name() string {
+	return "line:" + m.params.Line
+}
+
+// Vehicles returns a snapshot of all currently known vehicles of the line
+func (m *LineMonitor) Vehicles() []Vehicle {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.snapshot()
+}
+
+func (m *LineMonitor) run(ctx context.Context, done chan struct{}, updates chan LineUpdate) {
+	defer func() {
+		// Reset the monitor, whether it was stopped or its ctx was cancelled,
+		// so it can be started again with a fresh updates channel
+		m.mu.Lock()
+		m.cancel()
+		m.cancel, m.done = nil, nil
+		m.updates = make(chan LineUpdate, 1)
+		m.mu.Unlock()
+
+		m.client.metrics.PollerState(m.name(), false)
+		close(updates)
+		close(done)
+	}()
+
+	ticker := time.NewTicker(m.params.Interval)
+	defer ticker.Stop()
+
+	for {
+		m.poll(ctx, updates)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll queries all stops once and publishes the resulting update
+func (m *LineMonitor) poll(ctx context.Context, updates chan LineUpdate) {
+	update := LineUpdate{
+		Departures: make(map[string][]Departure),
+		Errors:     make(map[string]error),
+	}
+
+	shortTermChanges := true
+	for _, stopId := range m.params.Stops {
+		response, err := m.client.MonitorStop(ctx, &MonitorStopParams{
+			StopId:           stopId,
+			ShortTermChanges: &shortTermChanges,
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		if errors.Is(err, ErrNoDepartures) {
+			continue
+		}
+		if err != nil {
+			update.Errors[stopId] = err
+			continue
+		}
+
+		for _, departure := range response.Departures {
+			if departure.LineName == m.params.Line {
+				update.Departures[stopId] = append(update.Departures[stopId], departure)
+			}
+		}
+	}
+
+	m.mu.Lock()
+	now := time.Now()
+	for stopId, departures := range update.Departures {
+		for _, departure := range departures {
+			m.track(stopId, departure, now)
+		}
+	}
+	for id, vehicle := range m.vehicles {
+		if now.Sub(vehicle.LastSeen) > vehicleRetention {
+			delete(m.vehicles, id)
+		}
+	}
+	update.Vehicles = m.snapshot()
+	m.mu.Unlock()
+
+	// Replace a pending update nobody consumed yet with the latest one
+	select {
+	case <-updates:
+	default:
+	}
+	updates <- update
+}
+
+// track records the state of a departure at a stop on its vehicle
+func (m *LineMonitor) track(stopId string, departure Departure, now time.Time) {
+	vehicle, ok := m.vehicles[departure.Id]
+	if !ok {
+		vehicle = &Vehicle{Id: departure.Id, Line: departure.LineName, Direction: departure.Direction}
+		m.vehicles[departure.Id] = vehicle
+	}
+	vehicle.LastSeen = now
+
+	delay := StopDelay{
+		StopId:    stopId,
+		Scheduled: departure.ScheduledTime.Time,
+		RealTime:  departure.RealTime.Time,
+	}
+	if !delay.RealTime.IsZero() && !delay.Scheduled.IsZero() {
+		delay.Delay = delay.RealTime.Sub(delay.Scheduled)
+	}
+
+	for i := range vehicle.Progression {
+		if vehicle.Progression[i].StopId == stopId {
+			vehicle.Progression[i] = delay
+			return
+		}
+	}
+	vehicle.Progression = append(vehicle.Progression, delay)
+	sort.Slice(vehicle.Progression, func(i, j int) bool {
+		return vehicle.Progression[i].Scheduled.Before(vehicle.Progression[j].Scheduled)
+	})
+}
+
+// snapshot copies the known vehicles, ordered by their first scheduled stop. Requires m.mu.
+func (m *LineMonitor) snapshot() []Vehicle {
+	vehicles := make([]Vehicle, 0, len(m.vehicles))
+	for _, vehicle := range m.vehicles {
+		v := *vehicle
+		v.Progression = append([]StopDelay(nil), vehicle.Progression...)
+		vehicles = append(vehicles, v)
+	}
+	sort.Slice(vehicles, func(i, j int) bool {
+		a, b := vehicles[i].Progression, vehicles[j].Progression
+		if len(a) == 0 || len(b) == 0 {
+			return len(a) > len(b)
+		}
+		return a[0].Scheduled.Before(b[0].Scheduled)
+	})
+	return vehicles
+}
@@ -0,0 +1,108 @@
+package dvb
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/niclaszll/dvb-go/coords"
+)
+
+// Coordinate is a position of a route's geometry
+type Coordinate struct {
+	// X and Y are the Gauss-Krüger zone 4 coordinates (northing and easting) as returned by the API
+	X float64
+	Y float64
+
+	// Latitude and Longitude are the WGS84 position in degrees, e.g. for drawing on a map
+	Latitude  float64
+	Longitude float64
+}
+
+// MapSegment is a decoded MapData entry: the geometry of one part of a route
+type MapSegment struct {
+	// Mot is the mode of transport of the segment, e.g. MotTram or MotFootpath
+	Mot MotType
+
+	// Coordinates is the polyline of the segment in travel order
+	Coordinates []Coordinate
+}
+
+// DecodeMapData decodes a MapData entry of the form "Mot|x1|y1|x2|y2|...", where
+// the coordinates are Gauss-Krüger zone 4 northing and easting pairs.
+//
+// Example usage:
+//
+//	for _, entry := range route.MapData {
+//		segment, err := dvb.DecodeMapData(entry)
+//		if err != nil {
+//			continue
+//		}
+//		for _, c := range segment.Coordinates {
+//			fmt.Printf("%s %.5f,%.5f\n", segment.Mot, c.Latitude, c.Longitude)
+//		}
+//	}
+func DecodeMapData(mapData string) (MapSegment, error) {
+	fields := strings.Split(strings.TrimSuffix(mapData, "|"), "|")
+	if (len(fields)-1)%2 != 0 {
+		return MapSegment{}, fmt.Errorf("invalid map data: %q", mapData)
+	}
+
+	segment := MapSegment{
+		Mot:         ParseMot(fields[0]),
+		Coordinates: make([]Coordinate, 0, (len(fields)-1)/2),
+	}
+	for i := 1; i+1 < len(fields); i += 2 {
+		x, err := strconv.ParseFloat(fields[i], 64)
+		if err != nil {
+			return MapSegment{}, fmt.Errorf("invalid map data coordinate: %w", err)
+		}
+		y, err := strconv.ParseFloat(fields[i+1], 64)
+		if err != nil {
+			return MapSegment{}, fmt.Errorf("invalid map data coordinate: %w", err)
+		}
+		lat, lon := coords.GK4ToWGS84(x, y)
+		segment.Coordinates = append(segment.Coordinates, Coordinate{X: x, Y: y, Latitude: lat, Longitude: lon})
+	}
+	return segment, nil
+}
+
+// partialGeometry returns the polyline of the partial route at index i, taken from
+// its MapData entry or, if it has none, the straight lines between its stops
+func (r *Route) partialGeometry(i int) []Coordinate {
+	partial := &r.PartialRoutes[i]
+	if partial.MapDataIndex != nil && *partial.MapDataIndex >= 0 && *partial.MapDataIndex < len(r.MapData) {
+		segment, err := DecodeMapData(r.MapData[*partial.MapDataIndex])
+		if err == nil && len(segment.Coordinates) >= 2 {
+			return segment.Coordinates
+		}
+	}
+
+	var coordinates []Coordinate
+	for j := range partial.RegularStops {
+		stop := &partial.RegularStops[j]
+		if lat, lon, ok := stop.WGS84(); ok {
+			coordinates = append(coordinates, Coordinate{
+				X:         float64(stop.Latitude),
+				Y:         float64(stop.Longitude),
+				Latitude:  lat,
+				Longitude: lon,
+			})
+		}
+	}
+	return coordinates
+}
+
+// MapSegments decodes all MapData entries of the route. PartialRoute.MapDataIndex
+// refers to the returned segments.
+func (r *Route) MapSegments() ([]MapSegment, error) {
+	segments := make([]MapSegment, 0, len(r.MapData))
+	for _, entry := range r.MapData {
+		segment, err := DecodeMapData(entry)
+		if err != nil {
+			return nil, err
+		}
+		segments = append(segments, segment)
+	}
+	return segments, nil
+}
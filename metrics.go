@@ -0,0 +1,28 @@
+package dvb
+
+import "time"
+
+// MetricsRecorder receives instrumentation events from the client. Implementations
+// must be safe for concurrent use; see the dvbexpvar package for an example.
+type MetricsRecorder interface {
+	// RequestDone is called after every HTTP request with the API path (e.g. "/dm"),
+	// the response status code (0 if the request failed) and the request duration
+	RequestDone(endpoint string, statusCode int, duration time.Duration, err error)
+
+	// CacheLookup is called for every response cache lookup
+	CacheLookup(endpoint string, hit bool)
+
+	// Retry is called whenever a request is repeated, e.g. after failing over to a mirror
+	Retry(endpoint string)
+
+	// PollerState is called when a background poller (monitor) starts or stops
+	PollerState(name string, running bool)
+}
+
+// nopMetrics is the MetricsRecorder used if none is configured
+type nopMetrics struct{}
+
+func (nopMetrics) RequestDone(string, int, time.Duration, error) {}
+func (nopMetrics) CacheLookup(string, bool)                      {}
+func (nopMetrics) Retry(string)                                  {}
+func (nopMetrics) PollerState(string, bool)                      {}
@@ -0,0 +1,117 @@
+package dvb
+
+import "strings"
+
+// MotType is a mode of transport as reported by the API in Departure.Mot, Line.Mot,
+// MotChain.Type and Mot.Type. Values are normalized when decoded, so the German
+// and English spellings used by the different endpoints map to the same constant.
+// Modes not known to this package are kept verbatim.
+type MotType string
+
+const (
+	MotTram             MotType = "Tram"
+	MotCityBus          MotType = "CityBus"
+	MotIntercityBus     MotType = "IntercityBus"
+	MotPlusBus          MotType = "PlusBus"
+	MotSuburbanRailway  MotType = "SuburbanRailway"
+	MotTrain            MotType = "Train"
+	MotCableway         MotType = "Cableway"
+	MotFerry            MotType = "Ferry"
+	MotHailedSharedTaxi MotType = "HailedSharedTaxi"
+
+	// MotFootpath marks walking segments of a route
+	MotFootpath MotType = "Footpath"
+
+	// MotStayForConnection marks segments spent waiting at a stop for the next connection
+	MotStayForConnection MotType = "StayForConnection"
+
+	// MotStayInVehicle marks segments in which the vehicle continues under another line
+	MotStayInVehicle MotType = "StayInVehicle"
+)
+
+// motAliases maps lower-case spellings used by the API and its documentation to MotType
+var motAliases = map[string]MotType{
+	"tram":              MotTram,
+	"straßenbahn":       MotTram,
+	"strassenbahn":      MotTram,
+	"citybus":           MotCityBus,
+	"bus":               MotCityBus,
+	"stadtbus":          MotCityBus,
+	"intercitybus":      MotIntercityBus,
+	"regionalbus":       MotIntercityBus,
+	"überlandbus":       MotIntercityBus,
+	"plusbus":           MotPlusBus,
+	"suburbanrailway":   MotSuburbanRailway,
+	"s-bahn":            MotSuburbanRailway,
+	"sbahn":             MotSuburbanRailway,
+	"train":             MotTrain,
+	"zug":               MotTrain,
+	"bahn":              MotTrain,
+	"regionalbahn":      MotTrain,
+	"cableway":          MotCableway,
+	"seilbahn":          MotCableway,
+	"schwebebahn":       MotCableway,
+	"standseilbahn":     MotCableway,
+	"seil-/schwebebahn": MotCableway,
+	"ferry":             MotFerry,
+	"fähre":             MotFerry,
+	"faehre":            MotFerry,
+	"hailedsharedtaxi":  MotHailedSharedTaxi,
+	"rufbus":            MotHailedSharedTaxi,
+	"anrufsammeltaxi":   MotHailedSharedTaxi,
+	"alita":             MotHailedSharedTaxi,
+	"footpath":          MotFootpath,
+	"fußweg":            MotFootpath,
+	"fussweg":           MotFootpath,
+	"walking":           MotFootpath,
+	"stayforconnection": MotStayForConnection,
+	"stayinvehicle":     MotStayInVehicle,
+}
+
+// ParseMot normalizes a mode of transport. Known German and English spellings are
+// matched case-insensitively; unknown values are returned unchanged.
+//
+// Example usage:
+//
+//	ParseMot("Straßenbahn") // MotTram
+//	ParseMot("S-Bahn")      // MotSuburbanRailway
+func ParseMot(s string) MotType {
+	s = strings.TrimSpace(s)
+	if mot, ok := motAliases[strings.ToLower(s)]; ok {
+		return mot
+	}
+	return MotType(s)
+}
+
+// UnmarshalText normalizes the mode of transport when decoding, see ParseMot
+func (m *MotType) UnmarshalText(text []byte) error {
+	*m = ParseMot(string(text))
+	return nil
+}
+
+// String returns the canonical name of the mode of transport
+func (m MotType) String() string {
+	return string(m)
+}
+
+// Known reports whether the mode of transport is one of the constants of this package
+func (m MotType) Known() bool {
+	_, ok := motAliases[strings.ToLower(string(m))]
+	return ok && ParseMot(string(m)) == m
+}
+
+// IsRail reports whether the mode of transport runs on rails: trams, suburban railways and trains
+func (m MotType) IsRail() bool {
+	return m == MotTram || m == MotSuburbanRailway || m == MotTrain
+}
+
+// IsBus reports whether the mode of transport is a bus service, including on-demand buses
+func (m MotType) IsBus() bool {
+	return m == MotCityBus || m == MotIntercityBus || m == MotPlusBus || m == MotHailedSharedTaxi
+}
+
+// IsTransit reports whether the mode of transport is a public transport vehicle,
+// as opposed to walking or waiting segments of a route
+func (m MotType) IsTransit() bool {
+	return m != "" && m != MotFootpath && m != MotStayForConnection && m != MotStayInVehicle
+}
@@ -0,0 +1,112 @@
+package mqtt
+
+import (
+	"time"
+
+	"github.com/niclaszll/dvb-go"
+)
+
+// DeparturesMessage is the retained payload of the departures topic of a stop
+type DeparturesMessage struct {
+	StopId     string             `json:"stopId"`
+	Name       string             `json:"name"`
+	Place      string             `json:"place"`
+	Departures []DepartureMessage `json:"departures"`
+
+	// ScheduledOnly is set if the API could not be reached and the departures
+	// carry no real-time information
+	ScheduledOnly bool      `json:"scheduledOnly,omitempty"`
+	Updated       time.Time `json:"updated"`
+}
+
+// DepartureMessage is a single departure, flattened for simple consumers like
+// home-automation templates
+type DepartureMessage struct {
+	Id            string    `json:"id"`
+	Line          string    `json:"line"`
+	Direction     string    `json:"direction"`
+	Mot           string    `json:"mot"`
+	Platform      string    `json:"platform,omitempty"`
+	ScheduledTime time.Time `json:"scheduledTime"`
+	Time          time.Time `json:"time"`
+
+	// Delay is the delay in minutes
+	Delay int `json:"delay"`
+
+	// Minutes is the number of minutes until the departure at the time of publishing
+	Minutes   int    `json:"minutes"`
+	State     string `json:"state,omitempty"`
+	Cancelled bool   `json:"cancelled,omitempty"`
+}
+
+// EventMessage is the payload of the events and disruptions topics of a stop
+type EventMessage struct {
+	// Kind is a dvb.DepartureEventKind, or "route-change" on the disruptions topic
+	Kind          string    `json:"kind"`
+	StopId        string    `json:"stopId"`
+	Line          string    `json:"line"`
+	Direction     string    `json:"direction"`
+	DepartureId   string    `json:"departureId"`
+	ScheduledTime time.Time `json:"scheduledTime"`
+
+	// OldDelay and NewDelay are the delays in minutes of delay-changed events
+	OldDelay *int `json:"oldDelay,omitempty"`
+	NewDelay *int `json:"newDelay,omitempty"`
+
+	// OldPlatform and NewPlatform are set for platform-changed events
+	OldPlatform string `json:"oldPlatform,omitempty"`
+	NewPlatform string `json:"newPlatform,omitempty"`
+
+	// Reasons are the cancel reasons of cancelled departures or the route change
+	// IDs of route-change events
+	Reasons []string  `json:"reasons,omitempty"`
+	Time    time.Time `json:"time"`
+}
+
+// KindRouteChange marks disruption events of departures affected by a route change
+const KindRouteChange = "route-change"
+
+func departureMessage(departure dvb.Departure, now time.Time) DepartureMessage {
+	expected := departure.ExpectedTime()
+
+	message := DepartureMessage{
+		Id:            departure.Id,
+		Line:          departure.LineName,
+		Direction:     departure.Direction,
+		Mot:           departure.Mot.String(),
+		Platform:      departure.Platform.Name,
+		ScheduledTime: departure.ScheduledTime.Time,
+		Time:          expected,
+		Minutes:       max(int(departure.In(now).Minutes()), 0),
+		State:         departure.State,
+		Cancelled:     departure.IsCancelled(),
+	}
+	message.Delay = minutes(departure.Delay())
+	return message
+}
+
+func eventMessage(stopId string, event dvb.DepartureEvent, now time.Time) EventMessage {
+	message := EventMessage{
+		Kind:          string(event.Kind),
+		StopId:        stopId,
+		Line:          event.Departure.LineName,
+		Direction:     event.Departure.Direction,
+		DepartureId:   event.Departure.Id,
+		ScheduledTime: event.Departure.ScheduledTime.Time,
+		Time:          now,
+	}
+	switch event.Kind {
+	case dvb.DelayChanged:
+		oldDelay, newDelay := minutes(event.OldDelay), minutes(event.NewDelay)
+		message.OldDelay, message.NewDelay = &oldDelay, &newDelay
+	case dvb.PlatformChanged:
+		message.OldPlatform, message.NewPlatform = event.OldPlatform.Name, event.NewPlatform.Name
+	case dvb.Cancelled:
+		message.Reasons = event.Departure.CancelReasons
+	}
+	return message
+}
+
+func minutes(d time.Duration) int {
+	return int(d.Round(time.Minute).Minutes())
+}
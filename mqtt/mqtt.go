@@ -0,0 +1,367 @@
+// Package mqtt publishes departure updates and disruption events of configured
+// stops to an MQTT broker, e.g. for home-automation systems and e-ink displays.
+//
+// For every stop, the publisher writes to these topics below Config.TopicPrefix:
+//
+//	dvb/stop/33000028/departures   the current departure board (retained), see DeparturesMessage
+//	dvb/stop/33000028/events       changes between two polls, see EventMessage and dvb.DiffDepartures
+//	dvb/stop/33000028/disruptions  cancellations and route changes, see EventMessage
+//	dvb/status                     "online" or "offline" (retained, set as last will)
+//
+// Example usage:
+//
+//	publisher, err := mqtt.New(mqtt.Config{
+//		Client: dvb.NewClient(dvb.Config{}),
+//		Broker: "tcp://localhost:1883",
+//		Stops:  []mqtt.Stop{{StopId: "33000028", Lines: []string{"3", "11"}}},
+//	})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	if err := publisher.Start(ctx); err != nil {
+//		log.Fatal(err)
+//	}
+//	defer publisher.Stop()
+package mqtt
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	paho "github.com/eclipse/paho.mqtt.golang"
+
+	"github.com/niclaszll/dvb-go"
+)
+
+// publishTimeout limits how long publishing a single message may take
+const publishTimeout = 10 * time.Second
+
+// Config configures the publisher
+type Config struct {
+	// Client queries the departures
+	Client dvb.API
+
+	// Stops are the stops to publish
+	Stops []Stop
+
+	// Broker is the URL of the MQTT broker, e.g. "tcp://localhost:1883" or
+	// "ssl://broker:8883". Required unless Conn is set.
+	Broker string
+
+	// ClientId, Username and Password are used to connect to Broker (optional,
+	// ClientId defaults to "dvb-go")
+	ClientId string
+	Username string
+	Password string
+
+	// Conn is an existing MQTT client used instead of connecting to Broker (optional).
+	// The last will on the status topic must then be configured by the caller.
+	Conn paho.Client
+
+	// TopicPrefix is prepended to all topics (optional, defaults to "dvb")
+	TopicPrefix string
+
+	// QoS is the quality of service level of published messages (optional, defaults to 0)
+	QoS byte
+
+	// Interval is the time between two polls of all stops (optional, defaults to 30s)
+	Interval time.Duration
+
+	// Limit is the number of departures requested per stop (optional, defaults to 10)
+	Limit int
+
+	// Errors receives polling and publishing errors of individual stops (optional)
+	Errors func(stopId string, err error)
+}
+
+// Stop is a stop to publish
+type Stop struct {
+	// StopId is the DVB API stop ID
+	StopId string
+
+	// Lines restricts the published departures to these lines (optional, all lines if empty)
+	Lines []string
+}
+
+// stopState is the state of a stop between two polls
+type stopState struct {
+	differ dvb.DepartureDiffer
+
+	// reported are the disruptions already published, keyed by departure and disruption
+	reported map[string]bool
+}
+
+// Publisher polls the configured stops and publishes their departures
+type Publisher struct {
+	config Config
+	conn   paho.Client
+	owned  bool
+
+	// updateMu serializes polls, as the stop states are not safe for concurrent use
+	updateMu sync.Mutex
+	stops    map[string]*stopState
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// New creates a Publisher. Call Start to connect and begin polling.
+func New(config Config) (*Publisher, error) {
+	if config.Client == nil {
+		return nil, errors.New("client can not be nil")
+	}
+	if len(config.Stops) == 0 {
+		return nil, errors.New("stops can not be empty")
+	}
+	for _, stop := range config.Stops {
+		if stop.StopId == "" {
+			return nil, errors.New("stopid can not be empty")
+		}
+	}
+	if config.Conn == nil && config.Broker == "" {
+		return nil, errors.New("broker can not be empty")
+	}
+	if config.QoS > 2 {
+		return nil, errors.New("qos must be 0, 1 or 2")
+	}
+	if config.TopicPrefix == "" {
+		config.TopicPrefix = "dvb"
+	}
+	if config.ClientId == "" {
+		config.ClientId = "dvb-go"
+	}
+	if config.Interval <= 0 {
+		config.Interval = 30 * time.Second
+	}
+	if config.Limit <= 0 {
+		config.Limit = 10
+	}
+
+	p := &Publisher{
+		config: config,
+		conn:   config.Conn,
+		stops:  make(map[string]*stopState, len(config.Stops)),
+	}
+	if p.conn == nil {
+		options := paho.NewClientOptions().
+			AddBroker(config.Broker).
+			SetClientID(config.ClientId).
+			SetUsername(config.Username).
+			SetPassword(config.Password).
+			SetAutoReconnect(true).
+			SetWill(p.statusTopic(), "offline", config.QoS, true)
+		// Announce availability again after reconnecting, as the broker published the last will
+		options.SetOnConnectHandler(func(conn paho.Client) {
+			conn.Publish(p.statusTopic(), config.QoS, true, "online")
+		})
+		p.conn, p.owned = paho.NewClient(options), true
+	}
+	for _, stop := range config.Stops {
+		p.stops[stop.StopId] = &stopState{reported: make(map[string]bool)}
+	}
+	return p, nil
+}
+
+// Start connects to the broker if necessary and begins polling in the background
+// until Stop is called or ctx is cancelled. A publisher can only be started once.
+func (p *Publisher) Start(ctx context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.done != nil {
+		return errors.New("publisher already started")
+	}
+
+	if !p.conn.IsConnected() {
+		if err := wait(ctx, p.conn.Connect()); err != nil {
+			return fmt.Errorf("failed to connect to broker: %w", err)
+		}
+	}
+	if !p.owned {
+		if err := p.publish(ctx, p.statusTopic(), true, []byte("online")); err != nil {
+			return err
+		}
+	}
+
+	ctx, p.cancel = context.WithCancel(ctx)
+	p.done = make(chan struct{})
+	go p.run(ctx, p.done)
+	return nil
+}
+
+// Stop ends polling, marks the publisher offline and disconnects from the broker
+// if the publisher connected itself
+func (p *Publisher) Stop() {
+	p.mu.Lock()
+	cancel, done := p.cancel, p.done
+	p.mu.Unlock()
+
+	if cancel == nil {
+		return
+	}
+	cancel()
+	<-done
+
+	ctx, cancelPublish := context.WithTimeout(context.Background(), publishTimeout)
+	defer cancelPublish()
+	p.publish(ctx, p.statusTopic(), true, []byte("offline"))
+	if p.owned {
+		p.conn.Disconnect(250)
+	}
+}
+
+func (p *Publisher) run(ctx context.Context, done chan struct{}) {
+	defer close(done)
+
+	ticker := time.NewTicker(p.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		p.Update(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Update polls all stops once and publishes their departures and events. Stops
+// that fail are reported to Config.Errors; an error is only returned if all stops failed.
+func (p *Publisher) Update(ctx context.Context) error {
+	p.updateMu.Lock()
+	defer p.updateMu.Unlock()
+
+	var failed int
+	var lastErr error
+	for _, stop := range p.config.Stops {
+		err := p.update(ctx, stop)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err != nil {
+			failed++
+			lastErr = err
+			if p.config.Errors != nil {
+				p.config.Errors(stop.StopId, err)
+			}
+		}
+	}
+	if failed == len(p.config.Stops) {
+		return lastErr
+	}
+	return nil
+}
+
+// update polls and publishes a single stop
+func (p *Publisher) update(ctx context.Context, stop Stop) error {
+	response, err := p.config.Client.MonitorStop(ctx, &dvb.MonitorStopParams{
+		StopId:           stop.StopId,
+		Limit:            dvb.Int(p.config.Limit),
+		ShortTermChanges: dvb.Bool(true),
+	})
+	if err != nil && !errors.Is(err, dvb.ErrNoDepartures) {
+		return err
+	}
+
+	now := time.Now()
+	board := DeparturesMessage{StopId: stop.StopId, Departures: []DepartureMessage{}, Updated: now}
+	var departures []dvb.Departure
+	if response != nil {
+		board.Name, board.Place, board.ScheduledOnly = response.Name, response.Place, response.ScheduledOnly
+		for _, departure := range response.Departures {
+			if len(stop.Lines) == 0 || slices.Contains(stop.Lines, departure.LineName) {
+				departures = append(departures, departure)
+				board.Departures = append(board.Departures, departureMessage(departure, now))
+			}
+		}
+	}
+
+	var errs []error
+	errs = append(errs, p.publishJSON(ctx, p.stopTopic(stop.StopId, "departures"), true, board))
+
+	state := p.stops[stop.StopId]
+	for _, event := range state.differ.Update(departures, now) {
+		errs = append(errs, p.publishJSON(ctx, p.stopTopic(stop.StopId, "events"), false, eventMessage(stop.StopId, event, now)))
+	}
+	for _, disruption := range state.disruptions(stop.StopId, departures, now) {
+		errs = append(errs, p.publishJSON(ctx, p.stopTopic(stop.StopId, "disruptions"), false, disruption))
+	}
+	return errors.Join(errs...)
+}
+
+// disruptions returns the cancellations and route changes not reported yet.
+// Departures that left the board are forgotten.
+func (s *stopState) disruptions(stopId string, departures []dvb.Departure, now time.Time) []EventMessage {
+	var messages []EventMessage
+	current := make(map[string]bool)
+	for _, departure := range departures {
+		if departure.IsCancelled() {
+			key := departure.Id + "|cancelled"
+			current[key] = true
+			if !s.reported[key] {
+				messages = append(messages, eventMessage(stopId, dvb.DepartureEvent{Kind: dvb.Cancelled, Departure: departure}, now))
+			}
+		}
+
+		var changes []string
+		for _, change := range departure.RouteChanges {
+			key := departure.Id + "|" + change
+			current[key] = true
+			if !s.reported[key] {
+				changes = append(changes, change)
+			}
+		}
+		if len(changes) > 0 {
+			message := eventMessage(stopId, dvb.DepartureEvent{Departure: departure}, now)
+			message.Kind, message.Reasons = KindRouteChange, changes
+			messages = append(messages, message)
+		}
+	}
+	s.reported = current
+	return messages
+}
+
+func (p *Publisher) statusTopic() string {
+	return p.config.TopicPrefix + "/status"
+}
+
+func (p *Publisher) stopTopic(stopId, name string) string {
+	return p.config.TopicPrefix + "/stop/" + stopId + "/" + name
+}
+
+func (p *Publisher) publishJSON(ctx context.Context, topic string, retained bool, value any) error {
+	payload, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("failed to encode message: %w", err)
+	}
+	return p.publish(ctx, topic, retained, payload)
+}
+
+func (p *Publisher) publish(ctx context.Context, topic string, retained bool, payload []byte) error {
+	if err := wait(ctx, p.conn.Publish(topic, p.config.QoS, retained, payload)); err != nil {
+		return fmt.Errorf("failed to publish to %s: %w", topic, err)
+	}
+	return nil
+}
+
+// wait waits for token to complete, ctx to be done or publishTimeout to pass
+func wait(ctx context.Context, token paho.Token) error {
+	timer := time.NewTimer(publishTimeout)
+	defer timer.Stop()
+
+	select {
+	case <-token.Done():
+		return token.Error()
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return errors.New("timed out")
+	}
+}
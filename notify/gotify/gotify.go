@@ -0,0 +1,110 @@
+// Package gotify implements a notify.Notifier sending messages to a Gotify server.
+package gotify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/niclaszll/dvb-go/notify"
+)
+
+// Config holds configuration options for the Gotify notifier
+type Config struct {
+	ServerURL  string       // Base URL of the Gotify server (required)
+	Token      string       // Application token (required)
+	HTTPClient *http.Client // Custom HTTP client (optional)
+
+	// Templates customize title and message (optional)
+	Templates notify.Templates
+
+	// MinInterval and Burst limit the notification rate (optional, disabled if MinInterval is 0)
+	MinInterval time.Duration
+	Burst       int
+}
+
+// Notifier sends events as Gotify messages
+type Notifier struct {
+	config Config
+}
+
+// New creates a Gotify notifier. If a rate limit is configured, the returned
+// notifier drops events exceeding it with notify.ErrRateLimited.
+func New(config Config) notify.Notifier {
+	if config.HTTPClient == nil {
+		config.HTTPClient = http.DefaultClient
+	}
+
+	var notifier notify.Notifier = &Notifier{config: config}
+	if config.MinInterval > 0 {
+		notifier = notify.RateLimit(notifier, config.MinInterval, config.Burst)
+	}
+	return notifier
+}
+
+type message struct {
+	Title    string         `json:"title,omitempty"`
+	Message  string         `json:"message"`
+	Priority int            `json:"priority"`
+	Extras   map[string]any `json:"extras,omitempty"`
+}
+
+// Notify sends the event
+func (n *Notifier) Notify(ctx context.Context, event notify.Event) error {
+	if n.config.ServerURL == "" {
+		return fmt.Errorf("gotify: server URL can not be empty")
+	}
+
+	title, text, err := n.config.Templates.Render(event)
+	if err != nil {
+		return fmt.Errorf("gotify: failed to render template: %w", err)
+	}
+
+	msg := message{Title: title, Message: text, Priority: priority(event.Priority)}
+	if event.URL != "" {
+		msg.Extras = map[string]any{
+			"client::notification": map[string]any{"click": map[string]string{"url": event.URL}},
+		}
+	}
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request body: %w", err)
+	}
+
+	url := strings.TrimSuffix(n.config.ServerURL, "/") + "/message"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Gotify-Key", n.config.Token)
+
+	resp, err := n.config.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("gotify: HTTP %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+// priority maps event priorities to Gotify's 0 to 10 scale
+func priority(p notify.Priority) int {
+	switch {
+	case p >= notify.PriorityHigh:
+		return 8
+	case p <= notify.PriorityLow:
+		return 2
+	default:
+		return 5
+	}
+}
@@ -0,0 +1,100 @@
+// Package notify routes alert events produced by the dvb watchers (monitors,
+// bunching detection, route watchers, ...) to notification backends according to
+// user subscriptions with quiet hours and deduplication.
+//
+// Example usage:
+//
+//	registry := notify.NewRegistry()
+//	registry.RegisterNotifier("phone", notifier)
+//	registry.Subscribe(notify.Subscription{
+//		Id:          "commute",
+//		Rule:        notify.Rule{StopIds: []string{"33000028"}, Lines: []string{"11"}},
+//		Notifiers:   []string{"phone"},
+//		DedupWindow: 10 * time.Minute,
+//	})
+//	err := registry.Dispatch(ctx, event)
+package notify
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/niclaszll/dvb-go/analysis"
+)
+
+// Kind classifies events
+type Kind string
+
+const (
+	KindDelay                Kind = "delay"
+	KindCancellation         Kind = "cancellation"
+	KindBunching             Kind = "bunching"
+	KindDisruption           Kind = "disruption"
+	KindChangeoverEndangered Kind = "changeover-endangered"
+)
+
+// Priority ranks events; backends map it to their own priority levels
+type Priority int
+
+const (
+	PriorityLow Priority = iota - 1
+	PriorityDefault
+	PriorityHigh
+)
+
+// Event is an alert produced by a watcher
+type Event struct {
+	Kind Kind
+
+	// StopId, Line and RouteId identify what the event is about; empty if not applicable
+	StopId  string
+	Line    string
+	RouteId string
+
+	Title   string
+	Message string
+
+	// URL is an optional link opened when the notification is clicked
+	URL string
+
+	Priority Priority
+	Time     time.Time
+
+	// Key identifies the event for deduplication; events with the same key within
+	// a subscription's dedup window are delivered only once. Defaults to Kind and Title.
+	Key string
+}
+
+func (e Event) dedupKey() string {
+	if e.Key != "" {
+		return e.Key
+	}
+	return fmt.Sprintf("%s|%s|%s|%s|%s", e.Kind, e.StopId, e.Line, e.RouteId, e.Title)
+}
+
+// Notifier delivers events to a backend such as a push service or chat
+type Notifier interface {
+	Notify(ctx context.Context, event Event) error
+}
+
+// NotifierFunc adapts a function to the Notifier interface
+type NotifierFunc func(ctx context.Context, event Event) error
+
+func (f NotifierFunc) Notify(ctx context.Context, event Event) error {
+	return f(ctx, event)
+}
+
+// FromBunching converts a bunching event of the analysis package into an Event
+func FromBunching(event analysis.BunchingEvent) Event {
+	return Event{
+		Kind:   KindBunching,
+		StopId: event.StopId,
+		Line:   event.Line,
+		Title:  fmt.Sprintf("Line %s bunching", event.Line),
+		Message: fmt.Sprintf("Line %s towards %s: two vehicles %s apart (scheduled every %s)",
+			event.Line, event.Direction, event.Gap.Round(time.Second), event.ScheduledHeadway.Round(time.Second)),
+		Time: time.Now(),
+		Key:  fmt.Sprintf("bunching|%s|%s|%s", event.StopId, event.Leader.Id, event.Follower.Id),
+	}
+}
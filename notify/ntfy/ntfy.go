@@ -0,0 +1,100 @@
+// Package ntfy implements a notify.Notifier publishing to an ntfy.sh
+// (or self-hosted ntfy) topic.
+package ntfy
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/niclaszll/dvb-go/notify"
+)
+
+// Config holds configuration options for the ntfy notifier
+type Config struct {
+	ServerURL  string       // Base URL of the ntfy server (optional, defaults to https://ntfy.sh)
+	Topic      string       // Topic to publish to (required)
+	Token      string       // Access token for protected topics (optional)
+	HTTPClient *http.Client // Custom HTTP client (optional)
+
+	// StopURL is a deep link opened when the notification is clicked. The placeholder
+	// "{stop}" is replaced by the event's stop ID (optional, Event.URL takes precedence).
+	StopURL string
+}
+
+// Notifier publishes events as ntfy messages
+type Notifier struct {
+	config Config
+}
+
+// New creates an ntfy notifier
+func New(config Config) *Notifier {
+	if config.ServerURL == "" {
+		config.ServerURL = "https://ntfy.sh"
+	}
+	if config.HTTPClient == nil {
+		config.HTTPClient = http.DefaultClient
+	}
+	return &Notifier{config: config}
+}
+
+// Notify publishes the event to the configured topic
+func (n *Notifier) Notify(ctx context.Context, event notify.Event) error {
+	if n.config.Topic == "" {
+		return fmt.Errorf("ntfy: topic can not be empty")
+	}
+
+	url := strings.TrimSuffix(n.config.ServerURL, "/") + "/" + n.config.Topic
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, strings.NewReader(event.Message))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	if event.Title != "" {
+		req.Header.Set("Title", event.Title)
+	}
+	req.Header.Set("Priority", priority(event.Priority))
+	req.Header.Set("Tags", string(event.Kind))
+	if click := n.clickURL(event); click != "" {
+		req.Header.Set("Click", click)
+	}
+	if n.config.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+n.config.Token)
+	}
+
+	resp, err := n.config.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("ntfy: HTTP %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+func (n *Notifier) clickURL(event notify.Event) string {
+	if event.URL != "" {
+		return event.URL
+	}
+	if n.config.StopURL != "" && event.StopId != "" {
+		return strings.ReplaceAll(n.config.StopURL, "{stop}", event.StopId)
+	}
+	return ""
+}
+
+// priority maps event priorities to ntfy's 1 (min) to 5 (max) scale
+func priority(p notify.Priority) string {
+	switch {
+	case p >= notify.PriorityHigh:
+		return "5"
+	case p <= notify.PriorityLow:
+		return "2"
+	default:
+		return "3"
+	}
+}
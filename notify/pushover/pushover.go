@@ -0,0 +1,110 @@
+// Package pushover implements a notify.Notifier sending Pushover messages.
+package pushover
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/niclaszll/dvb-go/notify"
+)
+
+// Config holds configuration options for the Pushover notifier
+type Config struct {
+	APIURL     string       // Messages endpoint (optional, defaults to https://api.pushover.net/1/messages.json)
+	Token      string       // Application API token (required)
+	User       string       // User or group key (required)
+	Device     string       // Restricts delivery to a device (optional)
+	HTTPClient *http.Client // Custom HTTP client (optional)
+
+	// Templates customize title and message (optional)
+	Templates notify.Templates
+
+	// MinInterval and Burst limit the notification rate (optional, disabled if MinInterval is 0)
+	MinInterval time.Duration
+	Burst       int
+}
+
+// Notifier sends events as Pushover messages
+type Notifier struct {
+	config Config
+}
+
+// New creates a Pushover notifier. If a rate limit is configured, the returned
+// notifier drops events exceeding it with notify.ErrRateLimited.
+func New(config Config) notify.Notifier {
+	if config.APIURL == "" {
+		config.APIURL = "https://api.pushover.net/1/messages.json"
+	}
+	if config.HTTPClient == nil {
+		config.HTTPClient = http.DefaultClient
+	}
+
+	var notifier notify.Notifier = &Notifier{config: config}
+	if config.MinInterval > 0 {
+		notifier = notify.RateLimit(notifier, config.MinInterval, config.Burst)
+	}
+	return notifier
+}
+
+// Notify sends the event
+func (n *Notifier) Notify(ctx context.Context, event notify.Event) error {
+	title, message, err := n.config.Templates.Render(event)
+	if err != nil {
+		return fmt.Errorf("pushover: failed to render template: %w", err)
+	}
+
+	form := url.Values{}
+	form.Set("token", n.config.Token)
+	form.Set("user", n.config.User)
+	form.Set("message", message)
+	form.Set("priority", strconv.Itoa(priority(event.Priority)))
+	if title != "" {
+		form.Set("title", title)
+	}
+	if event.URL != "" {
+		form.Set("url", event.URL)
+	}
+	if n.config.Device != "" {
+		form.Set("device", n.config.Device)
+	}
+	if !event.Time.IsZero() {
+		form.Set("timestamp", strconv.FormatInt(event.Time.Unix(), 10))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.config.APIURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := n.config.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("pushover: HTTP %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+// priority maps event priorities to Pushover's -2 to 2 scale, avoiding emergency
+// priority 2 which requires acknowledgement parameters
+func priority(p notify.Priority) int {
+	switch {
+	case p >= notify.PriorityHigh:
+		return 1
+	case p <= notify.PriorityLow:
+		return -1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,197 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+)
+
+// Rule selects the events a subscription is interested in. Empty fields match everything;
+// non-empty fields must contain the event's value.
+type Rule struct {
+	Kinds    []Kind
+	StopIds  []string
+	Lines    []string
+	RouteIds []string
+
+	// MinPriority drops events below this priority
+	MinPriority Priority
+}
+
+// Matches reports whether the event satisfies the rule
+func (r Rule) Matches(event Event) bool {
+	return matches(r.Kinds, event.Kind) &&
+		matches(r.StopIds, event.StopId) &&
+		matches(r.Lines, event.Line) &&
+		matches(r.RouteIds, event.RouteId) &&
+		event.Priority >= r.MinPriority
+}
+
+func matches[T comparable](allowed []T, value T) bool {
+	return len(allowed) == 0 || slices.Contains(allowed, value)
+}
+
+// QuietHours suppresses notifications during a daily time range.
+// Ranges spanning midnight (e.g. 22:00 to 07:00) are supported.
+type QuietHours struct {
+	// Start and End are offsets from midnight
+	Start time.Duration
+	End   time.Duration
+
+	// Location is the time zone of the range (optional, defaults to time.Local)
+	Location *time.Location
+
+	// AllowHigh lets high-priority events through during quiet hours
+	AllowHigh bool
+}
+
+// Active reports whether t falls into the quiet hours
+func (q QuietHours) Active(t time.Time) bool {
+	location := q.Location
+	if location == nil {
+		location = time.Local
+	}
+	t = t.In(location)
+	offset := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+
+	if q.Start <= q.End {
+		return offset >= q.Start && offset < q.End
+	}
+	return offset >= q.Start || offset < q.End
+}
+
+// Subscription routes matching events to a set of registered notifiers
+type Subscription struct {
+	// Id identifies the subscription. This is required and must be unique.
+	Id string
+
+	Rule Rule
+
+	// Notifiers lists the names of registered notifiers to deliver to
+	Notifiers []string
+
+	// QuietHours suppresses notifications during the given time range (optional)
+	QuietHours *QuietHours
+
+	// DedupWindow delivers events with the same key only once within this duration (optional)
+	DedupWindow time.Duration
+}
+
+// Registry holds notifiers and subscriptions and dispatches events between them
+type Registry struct {
+	mu            sync.Mutex
+	notifiers     map[string]Notifier
+	subscriptions map[string]*Subscription
+	delivered     map[[2]string]time.Time
+	now           func() time.Time
+}
+
+// NewRegistry creates an empty Registry
+func NewRegistry() *Registry {
+	return &Registry{
+		notifiers:     make(map[string]Notifier),
+		subscriptions: make(map[string]*Subscription),
+		delivered:     make(map[[2]string]time.Time),
+		now:           time.Now,
+	}
+}
+
+// RegisterNotifier makes a notifier available to subscriptions under the given name
+func (r *Registry) RegisterNotifier(name string, notifier Notifier) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.notifiers[name] = notifier
+}
+
+// Subscribe adds or replaces a subscription
+func (r *Registry) Subscribe(subscription Subscription) error {
+	if subscription.Id == "" {
+		return errors.New("subscription id can not be empty")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, name := range subscription.Notifiers {
+		if _, ok := r.notifiers[name]; !ok {
+			return fmt.Errorf("unknown notifier %q", name)
+		}
+	}
+	r.subscriptions[subscription.Id] = &subscription
+	return nil
+}
+
+// Unsubscribe removes a subscription
+func (r *Registry) Unsubscribe(id string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.subscriptions, id)
+}
+
+// Dispatch delivers the event to the notifiers of all matching subscriptions,
+// honoring quiet hours and dedup windows. Each notifier receives the event at most
+// once, even if several subscriptions match. Delivery errors are joined.
+func (r *Registry) Dispatch(ctx context.Context, event Event) error {
+	if event.Time.IsZero() {
+		event.Time = r.now()
+	}
+
+	targets := r.targets(event)
+
+	var errs []error
+	for name, notifier := range targets {
+		if err := notifier.Notify(ctx, event); err != nil {
+			errs = append(errs, fmt.Errorf("notifier %s: %w", name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// targets selects the notifiers for an event and records the deliveries for deduplication
+func (r *Registry) targets(event Event) map[string]Notifier {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	key := event.dedupKey()
+	targets := make(map[string]Notifier)
+	for id, subscription := range r.subscriptions {
+		if !subscription.Rule.Matches(event) {
+			continue
+		}
+		if q := subscription.QuietHours; q != nil && q.Active(now) && !(q.AllowHigh && event.Priority >= PriorityHigh) {
+			continue
+		}
+		if subscription.DedupWindow > 0 {
+			dedup := [2]string{id, key}
+			if last, ok := r.delivered[dedup]; ok && now.Sub(last) < subscription.DedupWindow {
+				continue
+			}
+			r.delivered[dedup] = now
+		}
+		for _, name := range subscription.Notifiers {
+			if notifier, ok := r.notifiers[name]; ok {
+				targets[name] = notifier
+			}
+		}
+	}
+
+	r.prune(now)
+	return targets
+}
+
+// prune forgets deliveries older than any dedup window. Requires r.mu.
+func (r *Registry) prune(now time.Time) {
+	var window time.Duration
+	for _, subscription := range r.subscriptions {
+		window = max(window, subscription.DedupWindow)
+	}
+	for key, at := range r.delivered {
+		if now.Sub(at) >= window {
+			delete(r.delivered, key)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+)
+
+// Templates renders the title and message of an event for a backend.
+// Templates are executed with the Event as data, e.g. "Line {{.Line}}: {{.Message}}".
+// Empty templates keep the event's own Title and Message.
+type Templates struct {
+	Title   string
+	Message string
+}
+
+// Render returns the title and message of the event according to the templates
+func (t Templates) Render(event Event) (title, message string, err error) {
+	title, err = render(t.Title, event, event.Title)
+	if err != nil {
+		return "", "", err
+	}
+	message, err = render(t.Message, event, event.Message)
+	if err != nil {
+		return "", "", err
+	}
+	return title, message, nil
+}
+
+func render(text string, event Event, fallback string) (string, error) {
+	if text == "" {
+		return fallback, nil
+	}
+	tmpl, err := template.New("notification").Parse(text)
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	if err := tmpl.Execute(&b, event); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// ErrRateLimited is returned by rate-limited notifiers when an event was dropped
+var ErrRateLimited = errors.New("notification rate limit exceeded")
+
+// RateLimit wraps a notifier so it delivers at most burst events at once and
+// afterwards one event per interval. Events exceeding the limit are dropped
+// and ErrRateLimited is returned.
+func RateLimit(notifier Notifier, interval time.Duration, burst int) Notifier {
+	if burst < 1 {
+		burst = 1
+	}
+	return &rateLimited{
+		notifier: notifier,
+		interval: interval,
+		burst:    float64(burst),
+		tokens:   float64(burst),
+		last:     time.Now(),
+	}
+}
+
+// rateLimited is a token bucket in front of a notifier
+type rateLimited struct {
+	notifier Notifier
+	interval time.Duration
+	burst    float64
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+func (r *rateLimited) Notify(ctx context.Context, event Event) error {
+	if !r.allow(time.Now()) {
+		return ErrRateLimited
+	}
+	return r.notifier.Notify(ctx, event)
+}
+
+func (r *rateLimited) allow(now time.Time) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.interval > 0 {
+		r.tokens = min(r.burst, r.tokens+float64(now.Sub(r.last))/float64(r.interval))
+	} else {
+		r.tokens = r.burst
+	}
+	r.last = now
+
+	if r.tokens < 1 {
+		return false
+	}
+	r.tokens--
+	return true
+}
@@ -0,0 +1,73 @@
+package dvb
+
+import "strings"
+
+// Occupancy is how crowded a vehicle is. Values are ordered from empty to full,
+// so they can be compared directly, e.g. occupancy <= OccupancyFewSeats.
+type Occupancy int
+
+const (
+	OccupancyUnknown      Occupancy = iota // No occupancy information is available
+	OccupancyManySeats                     // Many seats are available
+	OccupancyFewSeats                      // Few seats are available
+	OccupancyStandingOnly                  // Only standing room is available
+	OccupancyFull                          // The vehicle is full
+)
+
+var occupancyNames = [...]string{"Unknown", "ManySeats", "FewSeats", "StandingOnly", "Full"}
+
+// occupancyAliases maps lower-case spellings used by the API to Occupancy
+var occupancyAliases = map[string]Occupancy{
+	"manyseats":    OccupancyManySeats,
+	"low":          OccupancyManySeats,
+	"fewseats":     OccupancyFewSeats,
+	"medium":       OccupancyFewSeats,
+	"standingonly": OccupancyStandingOnly,
+	"high":         OccupancyStandingOnly,
+	"full":         OccupancyFull,
+}
+
+// ParseOccupancy converts the API's occupancy value. Unknown or empty values
+// result in OccupancyUnknown.
+func ParseOccupancy(s string) Occupancy {
+	return occupancyAliases[strings.ToLower(strings.TrimSpace(s))]
+}
+
+// String returns the API's name of the occupancy, e.g. "ManySeats"
+func (o Occupancy) String() string {
+	if o < 0 || int(o) >= len(occupancyNames) {
+		return occupancyNames[OccupancyUnknown]
+	}
+	return occupancyNames[o]
+}
+
+// MarshalText encodes the occupancy as its name, see String
+func (o Occupancy) MarshalText() ([]byte, error) {
+	return []byte(o.String()), nil
+}
+
+// UnmarshalText decodes the occupancy, see ParseOccupancy
+func (o *Occupancy) UnmarshalText(text []byte) error {
+	*o = ParseOccupancy(string(text))
+	return nil
+}
+
+// Known reports whether occupancy information is available
+func (o Occupancy) Known() bool {
+	return o > OccupancyUnknown && o <= OccupancyFull
+}
+
+// HasSeats reports whether seats are known to be available
+func (o Occupancy) HasSeats() bool {
+	return o == OccupancyManySeats || o == OccupancyFewSeats
+}
+
+// AtMost reports whether the occupancy is known and not more crowded than limit,
+// e.g. to only show departures with seats:
+//
+//	if departure.Occupancy.AtMost(OccupancyFewSeats) {
+//		render(departure)
+//	}
+func (o Occupancy) AtMost(limit Occupancy) bool {
+	return o.Known() && o <= limit
+}
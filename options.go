@@ -0,0 +1,212 @@
+package dvb
+
+import (
+	"context"
+	"time"
+)
+
+// Option sets an optional parameter of an API call made with Departures, Lines,
+// FindPoints or PlanRoute. Options that don't apply to a call are ignored, e.g.
+// WithStopsOnly for Departures. A RequestOption is an Option as well, so per-request
+// overrides can be passed alongside the parameters.
+//
+// Example usage:
+//
+//	response, err := client.Departures(ctx, "33000028", dvb.WithLimit(10), dvb.WithShortTermChanges(),
+//		dvb.RequestTimeout(2*time.Second))
+type Option interface {
+	apply(*callOptions)
+}
+
+// optionFunc adapts a function to an Option
+type optionFunc func(*callOptions)
+
+func (f optionFunc) apply(o *callOptions) { f(o) }
+
+// callOptions collects the optional parameters of all API calls
+type callOptions struct {
+	format           *string
+	time             *time.Time
+	arrival          *bool
+	limit            *int
+	shortTermChanges *bool
+	mentzOnly        *bool
+	stopsOnly        *bool
+	assignedStops    *bool
+	dvbOnly          *bool
+	via              *string
+	viaDwellTime     *int
+	walkingFallback  *bool
+	sessionId        *string
+	routeSettings    *RouteSettings
+	accessibility    *AccessibilityParams
+	vias             []Via
+	mot              []MotType
+	request          []RequestOption
+}
+
+func newCallOptions(options []Option) callOptions {
+	var o callOptions
+	for _, option := range options {
+		option.apply(&o)
+	}
+	return o
+}
+
+// WithFormat sets the response format
+func WithFormat(format string) Option {
+	return optionFunc(func(o *callOptions) { o.format = &format })
+}
+
+// WithTime requests departures or routes at t instead of now
+func WithTime(t time.Time) Option {
+	return optionFunc(func(o *callOptions) { o.time = TimePtr(t) })
+}
+
+// WithArrival treats the time as arrival instead of departure time. For Departures
+// it requests arrivals at the stop, for PlanRoute routes arriving by the time.
+func WithArrival() Option {
+	return optionFunc(func(o *callOptions) { o.arrival = Ptr(true) })
+}
+
+// WithLimit restricts the number of departures or points returned
+func WithLimit(limit int) Option {
+	return optionFunc(func(o *callOptions) { o.limit = &limit })
+}
+
+// WithShortTermChanges includes short-term changes like delays or cancellations
+func WithShortTermChanges() Option {
+	return optionFunc(func(o *callOptions) { o.shortTermChanges = Ptr(true) })
+}
+
+// WithMentzOnly includes only data from the Mentz system (Departures only)
+func WithMentzOnly() Option {
+	return optionFunc(func(o *callOptions) { o.mentzOnly = Ptr(true) })
+}
+
+// WithStopsOnly limits the results to public transport stops (FindPoints only)
+func WithStopsOnly() Option {
+	return optionFunc(func(o *callOptions) { o.stopsOnly = Ptr(true) })
+}
+
+// WithAssignedStops includes only stops assigned to lines (FindPoints only)
+func WithAssignedStops() Option {
+	return optionFunc(func(o *callOptions) { o.assignedStops = Ptr(true) })
+}
+
+// WithDVBOnly includes only DVB stops (FindPoints only)
+func WithDVBOnly() Option {
+	return optionFunc(func(o *callOptions) { o.dvbOnly = Ptr(true) })
+}
+
+// WithVia routes through the given stop, staying there for dwell if positive, rounded
+// up to whole minutes like Via.DwellTime (PlanRoute only)
+func WithVia(stopId string, dwell time.Duration) Option {
+	return optionFunc(func(o *callOptions) {
+		o.via = &stopId
+		o.viaDwellTime = Via{StopId: stopId, DwellTime: dwell}.dwellMinutes()
+	})
+}
+
+// WithVias routes through the given stops in order, staying at each for its dwell time (PlanRoute only)
+func WithVias(vias ...Via) Option {
+	return optionFunc(func(o *callOptions) { o.vias = vias })
+}
+
+// WithWalkingFallback synthesizes a walking route if no connection is found (PlanRoute only)
+func WithWalkingFallback() Option {
+	return optionFunc(func(o *callOptions) { o.walkingFallback = Ptr(true) })
+}
+
+// WithSessionId continues a previous planning session (PlanRoute only)
+func WithSessionId(sessionId string) Option {
+	return optionFunc(func(o *callOptions) { o.sessionId = &sessionId })
+}
+
+// WithRouteSettings sends advanced routing preferences (PlanRoute only)
+func WithRouteSettings(settings RouteSettings) Option {
+	return optionFunc(func(o *callOptions) { o.routeSettings = &settings })
+}
+
+// WithAccessibility plans barrier-free journeys with the given mobility restrictions (PlanRoute only)
+func WithAccessibility(accessibility AccessibilityParams) Option {
+	return optionFunc(func(o *callOptions) { o.accessibility = &accessibility })
+}
+
+// WithMot restricts the lines to the given modes of transport (Lines only)
+func WithMot(mot ...MotType) Option {
+	return optionFunc(func(o *callOptions) { o.mot = mot })
+}
+
+// Departures is MonitorStop with the stop ID as argument and optional parameters
+// given as options, see MonitorStop.
+//
+// Example usage:
+//
+//	response, err := client.Departures(ctx, "33000028", dvb.WithLimit(10))
+func (c *Client) Departures(ctx context.Context, stopId string, options ...Option) (*MonitorStopResponse, error) {
+	o := newCallOptions(options)
+	return c.MonitorStop(ctx, &MonitorStopParams{
+		StopId:           stopId,
+		Format:           o.format,
+		Time:             o.time,
+		IsArrival:        o.arrival,
+		Limit:            o.limit,
+		ShortTermChanges: o.shortTermChanges,
+		MentzOnly:        o.mentzOnly,
+	}, o.request...)
+}
+
+// Lines is GetLines with the stop ID as argument and optional parameters given
+// as options, see GetLines.
+func (c *Client) Lines(ctx context.Context, stopId string, options ...Option) (*GetLinesResponse, error) {
+	o := newCallOptions(options)
+	return c.GetLines(ctx, &GetLinesParams{
+		StopId: stopId,
+		Format: o.format,
+		Mot:    o.mot,
+	}, o.request...)
+}
+
+// FindPoints is GetPoint with the search term as argument and optional parameters
+// given as options, see GetPoint.
+//
+// Example usage:
+//
+//	response, err := client.FindPoints(ctx, "Hauptbahnhof", dvb.WithStopsOnly(), dvb.WithLimit(5))
+func (c *Client) FindPoints(ctx context.Context, query string, options ...Option) (*GetPointResponse, error) {
+	o := newCallOptions(options)
+	return c.GetPoint(ctx, &GetPointParams{
+		Query:         query,
+		Format:        o.format,
+		StopsOnly:     o.stopsOnly,
+		AssignedStops: o.assignedStops,
+		Limit:         o.limit,
+		Dvb:           o.dvbOnly,
+	}, o.request...)
+}
+
+// PlanRoute is GetRoute with origin and destination as arguments and optional
+// parameters given as options, see GetRoute.
+//
+// Example usage:
+//
+//	response, err := client.PlanRoute(ctx, "33000028", "33000037", dvb.WithTime(departure))
+func (c *Client) PlanRoute(ctx context.Context, origin, destination string, options ...Option) (*GetRouteResponse, error) {
+	o := newCallOptions(options)
+	return c.GetRoute(ctx, &GetRouteParams{
+		Origin:           origin,
+		Destination:      destination,
+		Format:           o.format,
+		IsArrivalTime:    o.arrival,
+		ShortTermChanges: o.shortTermChanges,
+		Time:             o.time,
+		Via:              o.via,
+		ViaDwellTime:     o.viaDwellTime,
+		WalkingFallback:  o.walkingFallback,
+		SessionId:        o.sessionId,
+		Settings:         o.routeSettings,
+		Accessibility:    o.accessibility,
+		Vias:             o.vias,
+	}, o.request...)
+}
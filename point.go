@@ -0,0 +1,156 @@
+package dvb
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// PointType classifies the results of the point finder
+type PointType string
+
+const (
+	PointStop       PointType = "Stop"       // Public transport stop
+	PointAddress    PointType = "Address"    // Street address
+	PointPOI        PointType = "POI"        // Point of interest, e.g. a museum
+	PointCoordinate PointType = "Coordinate" // Raw coordinate
+	PointUnknown    PointType = "Unknown"    // Unrecognized or malformed result
+)
+
+// Point is a single parsed result of the point finder.
+type Point struct {
+	// Id is the identifier to pass to other API calls, e.g. the stop ID for MonitorStop
+	Id string
+
+	// Type classifies the point as stop, address or POI
+	Type PointType
+
+	// Place is the city or area of the point, may be empty for points in Dresden
+	Place string
+
+	// Name is the display name of the point
+	Name string
+
+	// X and Y are the Gauss-Krüger zone 4 coordinates (northing and easting), 0 if unknown
+	X int
+	Y int
+
+	// Raw is the unparsed pipe-delimited result as returned by the API
+	Raw string
+}
+
+// ParsePoint parses a pipe-delimited point finder result of the form
+// ID|type|place|name|x|y|...
+func ParsePoint(raw string) (Point, error) {
+	fields := strings.Split(raw, "|")
+	if len(fields) < 4 {
+		return Point{}, fmt.Errorf("invalid point: %q", raw)
+	}
+
+	point := Point{
+		Id:    fields[0],
+		Type:  pointType(fields[0], fields[1]),
+		Place: fields[2],
+		Name:  fields[3],
+		Raw:   raw,
+	}
+
+	if len(fields) >= 6 && fields[4] != "" && fields[5] != "" {
+		var err error
+		if point.X, err = strconv.Atoi(fields[4]); err != nil {
+			return Point{}, fmt.Errorf("invalid point coordinate: %w", err)
+		}
+		if point.Y, err = strconv.Atoi(fields[5]); err != nil {
+			return Point{}, fmt.Errorf("invalid point coordinate: %w", err)
+		}
+	}
+
+	return point, nil
+}
+
+// pointType derives the type from the type marker, falling back to the ID prefix
+func pointType(id, marker string) PointType {
+	switch {
+	case marker == "a" || strings.HasPrefix(id, "streetID:"):
+		return PointAddress
+	case marker == "p" || strings.HasPrefix(id, "poiID:"):
+		return PointPOI
+	case marker == "c" || strings.HasPrefix(id, "coord:"):
+		return PointCoordinate
+	case marker == "" || marker == "s":
+		if _, err := strconv.Atoi(id); err == nil {
+			return PointStop
+		}
+	}
+	return PointUnknown
+}
+
+// Points is a list of parsed point finder results
+type Points []Point
+
+// OnlyStops returns the points that are public transport stops
+func (p Points) OnlyStops() Points {
+	return p.filter(PointStop)
+}
+
+// OnlyAddresses returns the points that are street addresses
+func (p Points) OnlyAddresses() Points {
+	return p.filter(PointAddress)
+}
+
+// OnlyPOIs returns the points that are points of interest
+func (p Points) OnlyPOIs() Points {
+	return p.filter(PointPOI)
+}
+
+func (p Points) filter(pointType PointType) Points {
+	var filtered Points
+	for _, point := range p {
+		if point.Type == pointType {
+			filtered = append(filtered, point)
+		}
+	}
+	return filtered
+}
+
+// UnmarshalJSON parses a point from the API's pipe-delimited string. Strings that
+// can't be parsed are kept with type PointUnknown and only Raw set, so a single
+// malformed result does not fail the whole response.
+func (p *Point) UnmarshalJSON(data []byte) error {
+	var raw string
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	point, err := ParsePoint(raw)
+	if err != nil {
+		point = Point{Type: PointUnknown, Raw: raw}
+	}
+	*p = point
+	return nil
+}
+
+// MarshalJSON encodes the point as the API's pipe-delimited string
+func (p Point) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.String())
+}
+
+// String returns the raw pipe-delimited form of the point. Points constructed
+// without Raw are formatted as ID|type|place|name|x|y.
+func (p Point) String() string {
+	if p.Raw != "" {
+		return p.Raw
+	}
+
+	marker := ""
+	switch p.Type {
+	case PointAddress:
+		marker = "a"
+	case PointPOI:
+		marker = "p"
+	case PointCoordinate:
+		marker = "c"
+	}
+	return strings.Join([]string{p.Id, marker, p.Place, p.Name, strconv.Itoa(p.X), strconv.Itoa(p.Y)}, "|")
+}
@@ -0,0 +1,145 @@
+package dvb
+
+import (
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// minTokenSimilarity is the similarity below which a query word is considered
+// not to match a word of the point at all
+const minTokenSimilarity = 0.5
+
+// MatchScore rates how well the point's place and name match a search query, from
+// 0 (no match) to 1 (exact name match). Matching ignores case, umlauts and the
+// "straße"/"str." spelling and tolerates typos, so partial or misspelled queries
+// like "helmholtzstr" or "albertplaz" still score high.
+func (p Point) MatchScore(query string) float64 {
+	queryWords := matchWords(query)
+	if len(queryWords) == 0 {
+		return 0
+	}
+
+	name := matchWords(p.Name)
+	if strings.Join(name, " ") == strings.Join(queryWords, " ") {
+		return 1
+	}
+
+	words := append(matchWords(p.Place), name...)
+	var total float64
+	for _, queryWord := range queryWords {
+		var best float64
+		for _, word := range words {
+			best = max(best, wordSimilarity(queryWord, word))
+		}
+		total += best
+	}
+
+	// Cap below an exact name match, which always ranks first
+	return min(total/float64(len(queryWords)), 0.99)
+}
+
+// Rank returns the points ordered by how well they match query, best first, see
+// MatchScore. Points scoring equally keep the API's order. This is useful because
+// the API's ordering is often unhelpful for partial or misspelled queries.
+func (p Points) Rank(query string) Points {
+	scores := make([]float64, len(p))
+	indexes := make([]int, len(p))
+	for i, point := range p {
+		indexes[i] = i
+		scores[i] = point.MatchScore(query)
+	}
+
+	sort.SliceStable(indexes, func(i, j int) bool {
+		return scores[indexes[i]] > scores[indexes[j]]
+	})
+
+	ranked := make(Points, len(p))
+	for i, index := range indexes {
+		ranked[i] = p[index]
+	}
+	return ranked
+}
+
+// BestMatch returns the point matching query best, see MatchScore. Returns false
+// if no point matches, i.e. no point shares a (similar) word with query.
+//
+// Example usage:
+//
+//	response, err := client.GetPoint(ctx, &GetPointParams{Query: "helmholzstr"})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	if stop, ok := response.Points.OnlyStops().BestMatch("helmholzstr"); ok {
+//		fmt.Println(stop.Id, stop.Name)
+//	}
+func (p Points) BestMatch(query string) (Point, bool) {
+	var best Point
+	var bestScore float64
+	for _, point := range p {
+		if score := point.MatchScore(query); score > bestScore {
+			best, bestScore = point, score
+		}
+	}
+	return best, bestScore > 0
+}
+
+// wordSimilarity rates the similarity of a query word and a word of a point from
+// 0 to 1: 1 if equal, 0.9 if the query word is a prefix of the word (e.g. while
+// typing), the normalized Levenshtein similarity otherwise, and 0 below
+// minTokenSimilarity
+func wordSimilarity(queryWord, word string) float64 {
+	switch {
+	case queryWord == word:
+		return 1
+	case strings.HasPrefix(word, queryWord):
+		return 0.9
+	}
+
+	longest := max(utf8.RuneCountInString(queryWord), utf8.RuneCountInString(word))
+	similarity := 1 - float64(levenshtein(queryWord, word))/float64(longest)
+	if similarity < minTokenSimilarity {
+		return 0
+	}
+	return similarity
+}
+
+// levenshtein returns the edit distance between a and b in runes
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(rb)]
+}
+
+// matchWords splits s into normalized words for matching: lower case, umlauts
+// folded and "straße", "strasse" and "str." shortened to "str"
+func matchWords(s string) []string {
+	s = strings.NewReplacer(
+		"ä", "ae", "ö", "oe", "ü", "ue", "ß", "ss",
+		".", " ", ",", " ", "-", " ", "/", " ", "(", " ", ")", " ",
+	).Replace(strings.ToLower(s))
+
+	words := strings.Fields(s)
+	for i, word := range words {
+		if strings.HasSuffix(word, "strasse") {
+			words[i] = strings.TrimSuffix(word, "strasse") + "str"
+		}
+	}
+	return words
+}
@@ -0,0 +1,36 @@
+package dvb
+
+import "time"
+
+// Ptr returns a pointer to v, e.g. for the optional fields of the *Params structs
+func Ptr[T any](v T) *T {
+	return &v
+}
+
+// Bool returns a pointer to v
+func Bool(v bool) *bool {
+	return &v
+}
+
+// Int returns a pointer to v
+func Int(v int) *int {
+	return &v
+}
+
+// String returns a pointer to v
+func String(v string) *string {
+	return &v
+}
+
+// TimePtr returns a pointer to t, e.g. for the Time fields of MonitorStopParams
+// and GetRouteParams
+//
+// Example usage:
+//
+//	params := &dvb.MonitorStopParams{
+//		StopId: "33000028",
+//		Time:   dvb.TimePtr(time.Now().Add(30 * time.Minute)),
+//	}
+func TimePtr(t time.Time) *time.Time {
+	return &t
+}
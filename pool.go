@@ -0,0 +1,240 @@
+package dvb
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// ClientPoolConfig holds configuration options for creating a new ClientPool.
+type ClientPoolConfig struct {
+	// Client configures the shared upstream client. Its RateLimit applies to the
+	// pool as a whole.
+	Client Config
+
+	// MaxConcurrent bounds the number of requests in flight upstream across all
+	// tenants (optional, defaults to 4). Waiting requests are admitted round-robin
+	// per tenant, so a busy tenant cannot starve the others.
+	MaxConcurrent int
+
+	// DefaultQuota applies to tenants without an entry in Quotas (optional, unlimited if zero)
+	DefaultQuota TenantQuota
+
+	// Quotas are per-tenant request quotas keyed by tenant name (optional)
+	Quotas map[string]TenantQuota
+}
+
+// TenantQuota limits the request rate of a single tenant of a ClientPool.
+type TenantQuota struct {
+	RateLimit      float64 // Requests per second (optional, unlimited if 0)
+	RateLimitBurst int     // Requests that may be sent at once (optional, defaults to 1)
+}
+
+// ClientPool multiplexes many logical consumers (tenants) over one upstream
+// client with a bounded number of concurrent requests, fair queuing between
+// tenants and per-tenant quotas. It is meant for services that embed dvb-go on
+// behalf of many users.
+//
+// Example usage:
+//
+//	pool := dvb.NewClientPool(dvb.ClientPoolConfig{
+//		MaxConcurrent: 8,
+//		DefaultQuota:  dvb.TenantQuota{RateLimit: 2, RateLimitBurst: 5},
+//	})
+//	response, err := pool.Tenant("customer-42").MonitorStop(ctx, params)
+type ClientPool struct {
+	base   *Client
+	queue  *fairQueue
+	config ClientPoolConfig
+
+	mu      sync.Mutex
+	tenants map[string]*Client
+}
+
+// NewClientPool creates a new ClientPool with the provided configuration.
+func NewClientPool(config ClientPoolConfig) *ClientPool {
+	if config.MaxConcurrent <= 0 {
+		config.MaxConcurrent = 4
+	}
+
+	return &ClientPool{
+		base:    NewClient(config.Client),
+		queue:   newFairQueue(config.MaxConcurrent),
+		config:  config,
+		tenants: make(map[string]*Client),
+	}
+}
+
+// Tenant returns the client for the named tenant. All tenant clients share the
+// pool's connections, endpoints and global rate limit, while each is limited by
+// its own quota. The same client is returned for repeated calls with the same name.
+func (p *ClientPool) Tenant(name string) *Client {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if client, ok := p.tenants[name]; ok {
+		return client
+	}
+
+	quota, ok := p.config.Quotas[name]
+	if !ok {
+		quota = p.config.DefaultQuota
+	}
+
+	client := *p.base
+	client.tenant = &tenantGate{
+		name:    name,
+		queue:   p.queue,
+		limiter: newRateLimiter(quota.RateLimit, quota.RateLimitBurst),
+	}
+	p.tenants[name] = &client
+	return &client
+}
+
+// tenantGate admits the requests of a single tenant of a ClientPool
+type tenantGate struct {
+	name    string
+	queue   *fairQueue
+	limiter *rateLimiter
+}
+
+// acquire waits for the tenant's quota and a free upstream slot.
+// The returned function releases the slot.
+func (g *tenantGate) acquire(ctx context.Context) (func(), error) {
+	if err := g.limiter.wait(ctx); err != nil {
+		return nil, err
+	}
+	if err := g.queue.acquire(ctx, g.name); err != nil {
+		return nil, err
+	}
+
+	var once sync.Once
+	return func() { once.Do(g.queue.release) }, nil
+}
+
+// fairQueue hands out a bounded number of slots, serving waiting tenants round-robin
+type fairQueue struct {
+	mu      sync.Mutex
+	free    int
+	waiting map[string][]*queueWaiter
+	order   []string // tenants with waiters, in the order they are served
+}
+
+// queueWaiter is a request waiting for a slot
+type queueWaiter struct {
+	ready   chan struct{}
+	granted bool
+}
+
+func newFairQueue(slots int) *fairQueue {
+	return &fairQueue{
+		free:    slots,
+		waiting: make(map[string][]*queueWaiter),
+	}
+}
+
+// acquire blocks until the tenant is granted a slot or ctx is done
+func (q *fairQueue) acquire(ctx context.Context, tenant string) error {
+	q.mu.Lock()
+	if q.free > 0 && len(q.order) == 0 {
+		q.free--
+		q.mu.Unlock()
+		return nil
+	}
+
+	waiter := &queueWaiter{ready: make(chan struct{})}
+	if len(q.waiting[tenant]) == 0 {
+		q.order = append(q.order, tenant)
+	}
+	q.waiting[tenant] = append(q.waiting[tenant], waiter)
+	q.mu.Unlock()
+
+	select {
+	case <-waiter.ready:
+		return nil
+	case <-ctx.Done():
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if waiter.granted {
+		// The slot was handed over while giving up, pass it on
+		q.releaseLocked()
+		return ctx.Err()
+	}
+	q.remove(tenant, waiter)
+	return ctx.Err()
+}
+
+// release returns a slot, handing it to the next tenant in turn
+func (q *fairQueue) release() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.releaseLocked()
+}
+
+// releaseLocked implements release. Requires q.mu.
+func (q *fairQueue) releaseLocked() {
+	if len(q.order) == 0 {
+		q.free++
+		return
+	}
+
+	tenant := q.order[0]
+	waiters := q.waiting[tenant]
+	waiter := waiters[0]
+	q.remove(tenant, waiter)
+
+	// Move the tenant to the back so the others get their turn first
+	if len(q.waiting[tenant]) > 0 {
+		q.order = append(q.order[1:], tenant)
+	}
+
+	waiter.granted = true
+	close(waiter.ready)
+}
+
+// remove drops a waiter from the tenant's queue. Requires q.mu.
+func (q *fairQueue) remove(tenant string, waiter *queueWaiter) {
+	waiters := q.waiting[tenant]
+	for i, w := range waiters {
+		if w == waiter {
+			waiters = append(waiters[:i], waiters[i+1:]...)
+			break
+		}
+	}
+	if len(waiters) > 0 {
+		q.waiting[tenant] = waiters
+		return
+	}
+
+	delete(q.waiting, tenant)
+	for i, t := range q.order {
+		if t == tenant {
+			q.order = append(q.order[:i], q.order[i+1:]...)
+			break
+		}
+	}
+}
+
+// releaseBody releases an upstream slot once the response body is closed
+type releaseBody struct {
+	io.ReadCloser
+	release func()
+}
+
+func (b *releaseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.release()
+	return err
+}
+
+// holdSlot ties the release of the slot to the lifetime of the response body
+func holdSlot(resp *http.Response, release func()) {
+	if resp == nil {
+		release()
+		return
+	}
+	resp.Body = &releaseBody{ReadCloser: resp.Body, release: release}
+}
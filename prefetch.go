@@ -0,0 +1,258 @@
+package dvb
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// PrefetchParams contains the stops and routes a Prefetcher keeps warm.
+type PrefetchParams struct {
+	// Stops lists the stop IDs whose departures are prefetched
+	Stops []string
+
+	// Routes are the routes to prefetch, keyed by a name used to retrieve them
+	Routes map[string]GetRouteParams
+
+	// Lead is how long before a response's ExpirationTime it is refreshed (optional, defaults to 5s)
+	Lead time.Duration
+
+	// Interval is the refresh interval for responses without ExpirationTime,
+	// such as routes (optional, defaults to 60s)
+	Interval time.Duration
+
+	// MinInterval is the minimum time between two refreshes of the same entry,
+	// also used to retry after a failure (optional, defaults to 10s)
+	MinInterval time.Duration
+}
+
+// Prefetcher keeps the departures of a set of stops and a set of routes warm in
+// a local cache, refreshing each entry slightly before it expires, so requests
+// can be answered from fresh local data without waiting for the API.
+type Prefetcher struct {
+	client *Client
+	params PrefetchParams
+
+	mu      sync.Mutex
+	entries map[string]*prefetchEntry
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+// prefetchEntry is a single prefetched response
+type prefetchEntry struct {
+	fetch func(ctx context.Context) (any, time.Time, error)
+
+	value   any
+	expires time.Time
+	next    time.Time
+}
+
+// NewPrefetcher creates a Prefetcher for the given stops and routes. Call Start to begin prefetching.
+func (c *Client) NewPrefetcher(params PrefetchParams) (*Prefetcher, error) {
+	if len(params.Stops) == 0 && len(params.Routes) == 0 {
+		return nil, errors.New("stops and routes can not both be empty")
+	}
+	if params.Lead <= 0 {
+		params.Lead = 5 * time.Second
+	}
+	if params.Interval <= 0 {
+		params.Interval = 60 * time.Second
+	}
+	if params.MinInterval <= 0 {
+		params.MinInterval = 10 * time.Second
+	}
+
+	p := &Prefetcher{
+		client:  c,
+		params:  params,
+		entries: make(map[string]*prefetchEntry),
+	}
+
+	shortTermChanges := true
+	for _, stopId := range params.Stops {
+		if stopId == "" {
+			return nil, errors.New("stopid can not be empty")
+		}
+		stopParams := MonitorStopParams{StopId: stopId, ShortTermChanges: &shortTermChanges}
+		p.entries[stopKey(stopId)] = &prefetchEntry{
+			fetch: func(ctx context.Context) (any, time.Time, error) {
+				response, err := c.MonitorStop(ctx, &stopParams)
+				if err != nil {
+					return nil, time.Time{}, err
+				}
+				return response, response.ExpiresAt(), nil
+			},
+		}
+	}
+	for name, routeParams := range params.Routes {
+		p.entries[routeKey(name)] = &prefetchEntry{
+			fetch: func(ctx context.Context) (any, time.Time, error) {
+				response, err := c.GetRoute(ctx, &routeParams)
+				if err != nil {
+					return nil, time.Time{}, err
+				}
+				return response, time.Time{}, nil
+			},
+		}
+	}
+
+	return p, nil
+}
+
+func stopKey(stopId string) string { return "stop:" + stopId }
+func routeKey(name string) string  { return "route:" + name }
+
+// Start begins prefetching in the background until Stop is called or ctx is cancelled
+func (p *Prefetcher) Start(ctx context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.cancel != nil {
+		return errors.New("prefetcher already running")
+	}
+
+	ctx, p.cancel = context.WithCancel(ctx)
+	p.done = make(chan struct{})
+	p.client.metrics.PollerState("prefetch", true)
+	go p.run(ctx, p.done)
+	return nil
+}
+
+// Stop ends prefetching and waits for the background goroutine to exit
+func (p *Prefetcher) Stop() {
+	p.mu.Lock()
+	cancel, done := p.cancel, p.done
+	p.cancel, p.done = nil, nil
+	p.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+		<-done
+		p.client.metrics.PollerState("prefetch", false)
+	}
+}
+
+// MonitorStop returns the prefetched departures of a configured stop if they have
+// not expired yet. Otherwise the departures are requested from the API.
+func (p *Prefetcher) MonitorStop(ctx context.Context, stopId string) (*MonitorStopResponse, error) {
+	if value, ok := p.lookup(stopKey(stopId)); ok {
+		return value.(*MonitorStopResponse), nil
+	}
+
+	shortTermChanges := true
+	return p.client.MonitorStop(ctx, &MonitorStopParams{StopId: stopId, ShortTermChanges: &shortTermChanges})
+}
+
+// GetRoute returns the prefetched route with the given name if it is younger than
+// the refresh interval. Otherwise the route is requested from the API.
+func (p *Prefetcher) GetRoute(ctx context.Context, name string) (*GetRouteResponse, error) {
+	if value, ok := p.lookup(routeKey(name)); ok {
+		return value.(*GetRouteResponse), nil
+	}
+
+	routeParams, ok := p.params.Routes[name]
+	if !ok {
+		return nil, errors.New("unknown route: " + name)
+	}
+	return p.client.GetRoute(ctx, &routeParams)
+}
+
+// lookup returns a fresh prefetched value
+func (p *Prefetcher) lookup(key string) (any, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	entry, ok := p.entries[key]
+	hit := ok && entry.value != nil && time.Now().Before(entry.expires)
+	p.client.metrics.CacheLookup("prefetch", hit)
+	if !hit {
+		return nil, false
+	}
+	return entry.value, true
+}
+
+func (p *Prefetcher) run(ctx context.Context, done chan struct{}) {
+	defer close(done)
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		for _, entry := range p.due(time.Now()) {
+			p.refresh(ctx, entry)
+			if ctx.Err() != nil {
+				return
+			}
+		}
+
+		timer.Reset(time.Until(p.nextRefresh()))
+	}
+}
+
+// due returns the entries that need to be refreshed
+func (p *Prefetcher) due(now time.Time) []*prefetchEntry {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var entries []*prefetchEntry
+	for _, entry := range p.entries {
+		if !now.Before(entry.next) {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// nextRefresh returns when the next entry needs to be refreshed
+func (p *Prefetcher) nextRefresh() time.Time {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var next time.Time
+	for _, entry := range p.entries {
+		if next.IsZero() || entry.next.Before(next) {
+			next = entry.next
+		}
+	}
+	return next
+}
+
+// refresh fetches an entry and schedules its next refresh shortly before it expires.
+// Failed refreshes keep the previous value and are retried after MinInterval.
+func (p *Prefetcher) refresh(ctx context.Context, entry *prefetchEntry) {
+	value, expires, err := entry.fetch(ctx)
+	if ctx.Err() != nil {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	if err != nil {
+		entry.next = now.Add(p.params.MinInterval)
+		return
+	}
+
+	entry.value = value
+	entry.expires = expires
+	if expires.IsZero() {
+		entry.expires = now.Add(p.params.Interval)
+	}
+	entry.next = maxTime(entry.expires.Add(-p.params.Lead), now.Add(p.params.MinInterval))
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
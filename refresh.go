@@ -0,0 +1,83 @@
+package dvb
+
+import (
+	"context"
+	"errors"
+)
+
+// errNotRefreshable is returned by Refresh for responses that were not produced by a
+// Client or do not know the parameters of their request
+var errNotRefreshable = errors.New("response was not produced by a client with known parameters and can not be refreshed")
+
+// Refresh requests the departures again with the client and parameters that
+// produced this response and returns the updated copy. The response itself is
+// left unchanged.
+//
+// Example usage:
+//
+//	response, err := client.MonitorStop(ctx, params)
+//	// ... later, e.g. on "pull to refresh"
+//	response, err = response.Refresh(ctx)
+func (r *MonitorStopResponse) Refresh(ctx context.Context) (*MonitorStopResponse, error) {
+	if r.client == nil {
+		return nil, errNotRefreshable
+	}
+	return r.client.MonitorStop(ctx, r.params)
+}
+
+// Refresh requests the lines again with the client and parameters that produced
+// this response and returns the updated copy. The response itself is left unchanged.
+func (r *GetLinesResponse) Refresh(ctx context.Context) (*GetLinesResponse, error) {
+	if r.client == nil {
+		return nil, errNotRefreshable
+	}
+	return r.client.GetLines(ctx, r.params)
+}
+
+// Refresh requests the points again with the client and parameters that produced
+// this response and returns the updated copy. The response itself is left unchanged.
+func (r *GetPointResponse) Refresh(ctx context.Context) (*GetPointResponse, error) {
+	if r.client == nil {
+		return nil, errNotRefreshable
+	}
+	return r.client.GetPoint(ctx, r.params)
+}
+
+// Refresh plans the route again with the client and parameters that produced
+// this response and returns the updated copy. The response itself is left unchanged.
+// Note that a new planning session with a new SessionId is started. Responses of
+// GetEarlierRoutes and GetLaterRoutes can not be refreshed, as they do not know
+// the parameters of the session; use Earlier and Later instead.
+func (r *GetRouteResponse) Refresh(ctx context.Context) (*GetRouteResponse, error) {
+	if r.client == nil || r.params == nil {
+		return nil, errNotRefreshable
+	}
+	return r.client.GetRoute(ctx, r.params)
+}
+
+// Refresh requests the trip details again with the client and parameters that produced
+// this response and returns the updated copy. The response itself is left unchanged.
+func (r *GetTripDetailsResponse) Refresh(ctx context.Context) (*GetTripDetailsResponse, error) {
+	if r.client == nil {
+		return nil, errNotRefreshable
+	}
+	return r.client.GetTripDetails(ctx, r.params)
+}
+
+// Refresh requests the route changes again with the client and parameters that produced
+// this response and returns the updated copy. The response itself is left unchanged.
+func (r *GetRouteChangesResponse) Refresh(ctx context.Context) (*GetRouteChangesResponse, error) {
+	if r.client == nil {
+		return nil, errNotRefreshable
+	}
+	return r.client.GetRouteChanges(ctx, r.params)
+}
+
+// Refresh requests the timetable again with the client and parameters that produced
+// this response and returns the updated copy. The response itself is left unchanged.
+func (r *GetTimetableResponse) Refresh(ctx context.Context) (*GetTimetableResponse, error) {
+	if r.client == nil {
+		return nil, errNotRefreshable
+	}
+	return r.client.GetTimetable(ctx, r.params)
+}
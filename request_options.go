@@ -0,0 +1,112 @@
+package dvb
+
+import (
+	"context"
+	"maps"
+	"net/url"
+	"time"
+)
+
+// RequestOption overrides a client default for a single API call, e.g. to send an
+// additional header or use a shorter timeout, without creating a second Client.
+// The options apply to all requests made by the call, including retries and
+// requests made internally (e.g. by the walking fallback of GetRoute).
+//
+// Example usage:
+//
+//	response, err := client.MonitorStop(ctx, params,
+//		dvb.RequestHeader("X-Request-Id", requestId),
+//		dvb.RequestTimeout(2*time.Second),
+//	)
+//
+// A RequestOption can also be passed as an Option, e.g. to Departures.
+type RequestOption func(*requestSettings)
+
+// apply makes a RequestOption usable as an Option
+func (opt RequestOption) apply(o *callOptions) { o.request = append(o.request, opt) }
+
+// requestSettings collects the overrides of all request options of a call
+type requestSettings struct {
+	headers map[string]string
+	query   url.Values
+	timeout time.Duration
+}
+
+// requestSettingsKey is the context key of the requestSettings of a call
+type requestSettingsKey struct{}
+
+// RequestHeader sets a header on the requests of the call, overriding the client's
+// headers of the same name
+func RequestHeader(name, value string) RequestOption {
+	return func(s *requestSettings) {
+		if s.headers == nil {
+			s.headers = make(map[string]string)
+		}
+		s.headers[name] = value
+	}
+}
+
+// RequestTimeout bounds the call, including retries, to d. The client's Timeout still
+// applies to each attempt.
+func RequestTimeout(d time.Duration) RequestOption {
+	return func(s *requestSettings) { s.timeout = d }
+}
+
+// RequestQueryParam sets a query parameter on the requests of the call, overriding
+// the value derived from the method's parameters, e.g. for API parameters this
+// package does not model yet
+func RequestQueryParam(name, value string) RequestOption {
+	return func(s *requestSettings) {
+		if s.query == nil {
+			s.query = make(url.Values)
+		}
+		s.query.Set(name, value)
+	}
+}
+
+// withRequestOptions stores the settings of options in ctx, merged with those of
+// an enclosing call, and applies the timeout. The returned cancel function must
+// be called when the call returns.
+func withRequestOptions(ctx context.Context, options []RequestOption) (context.Context, context.CancelFunc) {
+	if len(options) == 0 {
+		return ctx, func() {}
+	}
+
+	var settings requestSettings
+	if parent, ok := ctx.Value(requestSettingsKey{}).(*requestSettings); ok {
+		settings.headers = maps.Clone(parent.headers)
+		settings.query = maps.Clone(parent.query)
+	}
+	for _, option := range options {
+		option(&settings)
+	}
+	ctx = context.WithValue(ctx, requestSettingsKey{}, &settings)
+
+	if settings.timeout > 0 {
+		return context.WithTimeout(ctx, settings.timeout)
+	}
+	return ctx, func() {}
+}
+
+// withSettings applies the request options stored in ctx to opts. The query and
+// headers are copied, so opts can be shared between calls.
+func (opts requestOptions) withSettings(ctx context.Context) requestOptions {
+	settings, ok := ctx.Value(requestSettingsKey{}).(*requestSettings)
+	if !ok {
+		return opts
+	}
+
+	if len(settings.query) > 0 {
+		query := make(url.Values, len(opts.Query)+len(settings.query))
+		maps.Copy(query, opts.Query)
+		maps.Copy(query, settings.query)
+		opts.Query = query
+	}
+	if len(settings.headers) > 0 {
+		headers := make(map[string]string, len(opts.Headers)+len(settings.headers))
+		maps.Copy(headers, opts.Headers)
+		maps.Copy(headers, settings.headers)
+		opts.Headers = headers
+	}
+	return opts
+}
@@ -0,0 +1,109 @@
+package dvb
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how transient failures are retried. A request is retried
+// on network errors and responses with a retryable status code, after all
+// configured endpoints have been tried. Client errors like 404, API status
+// errors and cancelled contexts are never retried.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts including the first one
+	// (optional, retries are disabled if 0 or 1)
+	MaxAttempts int
+
+	// BaseBackoff is the wait before the first retry; it doubles with every further
+	// retry (optional, defaults to 200ms)
+	BaseBackoff time.Duration
+
+	// MaxBackoff caps the wait between two attempts (optional, defaults to 5s)
+	MaxBackoff time.Duration
+
+	// Jitter randomizes each wait by up to this fraction, e.g. 0.2 for ±20%, so
+	// clients don't retry in lockstep (optional, no jitter if 0)
+	Jitter float64
+
+	// RetryableStatusCodes are the HTTP status codes that are retried
+	// (optional, defaults to 429, 500, 502, 503 and 504)
+	RetryableStatusCodes []int
+}
+
+// defaultRetryableStatusCodes are retried unless RetryPolicy.RetryableStatusCodes is set
+var defaultRetryableStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// withDefaults fills in the defaults of unset fields
+func (p RetryPolicy) withDefaults() RetryPolicy {
+	if p.BaseBackoff <= 0 {
+		p.BaseBackoff = 200 * time.Millisecond
+	}
+	if p.MaxBackoff <= 0 {
+		p.MaxBackoff = 5 * time.Second
+	}
+	if p.RetryableStatusCodes == nil {
+		p.RetryableStatusCodes = defaultRetryableStatusCodes
+	}
+	return p
+}
+
+// retryable reports whether the outcome of an attempt should be retried. Only
+// transport errors and responses with a retryable status code are retried;
+// cancellations and errors that would fail the same way again, like an invalid
+// base URL or a failing token source, are not.
+func (p *RetryPolicy) retryable(resp *http.Response, err error) bool {
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return false
+		}
+		// Timeouts of a single attempt (Config.Timeout) are transport errors and
+		// retried; the caller's own deadline is checked by doRequest
+		var transportErr *url.Error
+		return errors.As(err, &transportErr)
+	}
+	return slices.Contains(p.RetryableStatusCodes, resp.StatusCode)
+}
+
+// backoff returns the wait before the given retry (1 for the first retry),
+// honoring a Retry-After header of the previous response
+func (p *RetryPolicy) backoff(retry int, resp *http.Response) time.Duration {
+	wait := p.BaseBackoff << min(retry-1, 30)
+	if wait <= 0 || wait > p.MaxBackoff {
+		wait = p.MaxBackoff
+	}
+	if p.Jitter > 0 {
+		wait = time.Duration(float64(wait) * (1 + p.Jitter*(2*rand.Float64()-1)))
+	}
+
+	if resp != nil {
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds > 0 {
+			wait = max(wait, min(time.Duration(seconds)*time.Second, p.MaxBackoff))
+		}
+	}
+	return wait
+}
+
+// sleep waits for d or until ctx is done
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,76 @@
+package dvb
+
+import (
+	"iter"
+	"time"
+)
+
+// DepartureTime returns the departure time of the route, taken from the first stop
+// of the first segment that has stops. The real-time value is preferred and the
+// scheduled time is used as a fallback. Returns the zero time if it is unknown.
+func (r *Route) DepartureTime() time.Time {
+	for _, partial := range r.PartialRoutes {
+		if len(partial.RegularStops) == 0 {
+			continue
+		}
+		stop := partial.RegularStops[0]
+		return firstDate(stop.DepartureRealTime, &stop.DepartureTime)
+	}
+	return time.Time{}
+}
+
+// ArrivalTime returns the arrival time of the route, taken from the last stop
+// of the last segment that has stops. The real-time value is preferred and the
+// scheduled time is used as a fallback. Returns the zero time if it is unknown.
+func (r *Route) ArrivalTime() time.Time {
+	for i := len(r.PartialRoutes) - 1; i >= 0; i-- {
+		stops := r.PartialRoutes[i].RegularStops
+		if len(stops) == 0 {
+			continue
+		}
+		stop := stops[len(stops)-1]
+		return firstDate(stop.ArrivalRealTime, &stop.ArrivalTime)
+	}
+	return time.Time{}
+}
+
+// Legs returns an iterator over the route's segments in travel order,
+// skipping zero-length footpaths the API inserts between connecting services.
+func (r *Route) Legs() iter.Seq[*PartialRoute] {
+	return func(yield func(*PartialRoute) bool) {
+		for i := range r.PartialRoutes {
+			partial := &r.PartialRoutes[i]
+			if partial.Mot.Type == MotFootpath && partial.Duration == 0 {
+				continue
+			}
+			if !yield(partial) {
+				return
+			}
+		}
+	}
+}
+
+// BoardingStop returns the stop where this segment starts, or nil if the segment has no stops.
+func (p *PartialRoute) BoardingStop() *RegularStop {
+	if len(p.RegularStops) == 0 {
+		return nil
+	}
+	return &p.RegularStops[0]
+}
+
+// AlightingStop returns the stop where this segment ends, or nil if the segment has no stops.
+func (p *PartialRoute) AlightingStop() *RegularStop {
+	if len(p.RegularStops) == 0 {
+		return nil
+	}
+	return &p.RegularStops[len(p.RegularStops)-1]
+}
+
+// IntermediateStops returns the stops passed between boarding and alighting.
+// The returned slice shares its backing array with RegularStops.
+func (p *PartialRoute) IntermediateStops() []RegularStop {
+	if len(p.RegularStops) <= 2 {
+		return nil
+	}
+	return p.RegularStops[1 : len(p.RegularStops)-1]
+}
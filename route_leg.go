@@ -0,0 +1,153 @@
+package dvb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+)
+
+// ReplaceLeg re-queries a transit segment of a route at one of its alternative
+// departure times (see PartialRoute.NextDepartureTimes and PreviousDepartureTimes)
+// and returns a copy of the route with the segment replaced, e.g. for "take the
+// next tram instead" interactions. The other segments are left unchanged, so the
+// returned route may miss a connection; check InterchangeDetails or TransferWarnings.
+//
+// Parameters:
+//   - ctx: Context for the request, allowing for cancellation and timeouts
+//   - route: The route to change, e.g. from a GetRoute response. It is not modified.
+//   - leg: The index of the segment in route.PartialRoutes. It must be a transit segment.
+//   - departure: The new departure time, one of the segment's alternative departure times
+//   - requestOpts: Optional per-request overrides like headers or a timeout, see RequestOption
+//
+// Returns:
+//   - *Route: A synthesized copy of the route with the replaced segment
+//   - error: Returns an error if the segment is not a transit segment, if departure is
+//     not one of its alternative departure times, or if the API request fails.
+//     A *NotFoundError wrapping ErrNoRoute is returned if the API no longer offers
+//     the departure.
+//
+// Example usage:
+//
+//	route := &response.Routes[0]
+//	partial := &route.PartialRoutes[0]
+//	if len(partial.NextDepartureTimes) > 0 {
+//		later, err := client.ReplaceLeg(ctx, route, 0, partial.NextDepartureTimes[0].Time)
+//		if err != nil {
+//			log.Fatal(err)
+//		}
+//		for _, warning := range later.TransferWarnings(2) {
+//			fmt.Println(warning.Interchange.To.Name, warning.Risk)
+//		}
+//	}
+func (c *Client) ReplaceLeg(ctx context.Context, route *Route, leg int, departure time.Time, requestOpts ...RequestOption) (*Route, error) {
+	if route == nil || leg < 0 || leg >= len(route.PartialRoutes) {
+		return nil, fmt.Errorf("leg %d out of range", leg)
+	}
+	partial := &route.PartialRoutes[leg]
+	if !partial.isTransit() {
+		return nil, errors.New("leg must be a transit segment")
+	}
+	if !partial.hasAlternative(departure) {
+		return nil, fmt.Errorf("%s is not an alternative departure time of leg %d", departure.Format(time.RFC3339), leg)
+	}
+
+	boarding, alighting := partial.BoardingStop(), partial.AlightingStop()
+	isArrivalTime, shortTermChanges := false, true
+	response, err := c.GetRoute(ctx, &GetRouteParams{
+		Origin:           boarding.DataId,
+		Destination:      alighting.DataId,
+		Time:             &departure,
+		IsArrivalTime:    &isArrivalTime,
+		ShortTermChanges: &shortTermChanges,
+	}, requestOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query leg %d: %w", leg, err)
+	}
+
+	replacement, mapData := matchLeg(response.Routes, partial, departure)
+	if replacement == nil {
+		return nil, &NotFoundError{
+			Err:    ErrNoRoute,
+			Query:  boarding.DataId + " → " + alighting.DataId + " at " + departure.Format(time.RFC3339),
+			Status: response.Status,
+		}
+	}
+
+	spliced := *route
+	spliced.PartialRoutes = slices.Clone(route.PartialRoutes)
+	spliced.MapData = slices.Clone(route.MapData)
+	if mapData != "" {
+		// Keep the segment's own map data index if it has one, so the route's
+		// other indices stay valid
+		if partial.MapDataIndex != nil && *partial.MapDataIndex < len(spliced.MapData) {
+			spliced.MapData[*partial.MapDataIndex] = mapData
+			replacement.MapDataIndex = partial.MapDataIndex
+		} else {
+			index := len(spliced.MapData)
+			spliced.MapData = append(spliced.MapData, mapData)
+			replacement.MapDataIndex = &index
+		}
+	} else {
+		replacement.MapDataIndex = partial.MapDataIndex
+	}
+	replacement.PartialRouteId = partial.PartialRouteId
+	spliced.PartialRoutes[leg] = *replacement
+	spliced.Synthesized = true
+
+	if departure, arrival := spliced.DepartureTime(), spliced.ArrivalTime(); !departure.IsZero() && !arrival.IsZero() {
+		spliced.Duration = int(math.Round(arrival.Sub(departure).Minutes()))
+	}
+	return &spliced, nil
+}
+
+// hasAlternative reports whether departure is one of the segment's next or previous departure times
+func (p *PartialRoute) hasAlternative(departure time.Time) bool {
+	for _, times := range [][]Time{p.NextDepartureTimes, p.PreviousDepartureTimes} {
+		for _, t := range times {
+			if t.Equal(departure) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchLeg returns a copy of the transit segment of routes that serves the line of
+// leg from its boarding stop at the scheduled departure, together with the
+// segment's map data. Returns nil if no route contains such a segment.
+func matchLeg(routes []Route, leg *PartialRoute, departure time.Time) (*PartialRoute, string) {
+	line := leg.lineId()
+	boarding := leg.BoardingStop().DataId
+	for _, route := range routes {
+		for _, partial := range route.PartialRoutes {
+			if !partial.isTransit() || partial.lineId() != line {
+				continue
+			}
+			stop := partial.BoardingStop()
+			if stop.DataId != boarding || !stop.DepartureTime.Equal(departure) {
+				continue
+			}
+
+			var mapData string
+			if partial.MapDataIndex != nil && *partial.MapDataIndex >= 0 && *partial.MapDataIndex < len(route.MapData) {
+				mapData = route.MapData[*partial.MapDataIndex]
+			}
+			return &partial, mapData
+		}
+	}
+	return nil, ""
+}
+
+// lineId identifies the line of a segment by its DlId, or its name if the DlId is unknown
+func (p *PartialRoute) lineId() string {
+	if p.Mot.DlId != nil && *p.Mot.DlId != "" {
+		return *p.Mot.DlId
+	}
+	if p.Mot.Name != nil {
+		return *p.Mot.Name
+	}
+	return ""
+}
@@ -0,0 +1,139 @@
+package dvb
+
+import (
+	"math"
+	"sort"
+)
+
+// NearbyStop is a stop close to the geometry of a route
+type NearbyStop struct {
+	Point Point
+
+	// Distance is the distance in meters between the stop and the closest point of the route
+	Distance float64
+
+	// PartialRouteIndex is the index in Route.PartialRoutes of the segment closest to the stop
+	PartialRouteIndex int
+
+	// Offset is the distance in meters along the route from its start to the closest point
+	Offset float64
+}
+
+// routeSegment is a straight piece of a route's geometry in Gauss-Krüger coordinates
+type routeSegment struct {
+	partial  int
+	from, to [2]float64
+	offset   float64
+}
+
+// StopsNear returns the stops within radius meters of the route's geometry, in the
+// order they are passed along the route. Candidate stops can come from local data
+// or a point search, e.g. GetPoint results filtered with OnlyStops. Stops without
+// coordinates are ignored.
+//
+// The geometry is taken from the route's MapData, falling back to the straight
+// lines between the stops of segments without map data.
+//
+// Example usage:
+//
+//	// Find stops near the pharmacy to get off early
+//	response, err := client.GetPoint(ctx, &GetPointParams{Query: "Apotheke Neustadt"})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, nearby := range route.StopsNear(response.Points.OnlyStops(), 300) {
+//		fmt.Printf("%s is %.0fm from the route\n", nearby.Point.Name, nearby.Distance)
+//	}
+func (r *Route) StopsNear(stops []Point, radius float64) []NearbyStop {
+	segments := r.segments()
+	if len(segments) == 0 {
+		return nil
+	}
+
+	var nearby []NearbyStop
+	for _, stop := range stops {
+		if stop.X == 0 || stop.Y == 0 {
+			continue
+		}
+		p := [2]float64{float64(stop.X), float64(stop.Y)}
+
+		best := NearbyStop{Distance: math.Inf(1)}
+		for _, segment := range segments {
+			distance, along := distanceToSegment(p, segment.from, segment.to)
+			if distance < best.Distance {
+				best = NearbyStop{
+					Point:             stop,
+					Distance:          distance,
+					PartialRouteIndex: segment.partial,
+					Offset:            segment.offset + along,
+				}
+			}
+		}
+		if best.Distance <= radius {
+			nearby = append(nearby, best)
+		}
+	}
+
+	sort.SliceStable(nearby, func(i, j int) bool {
+		return nearby[i].Offset < nearby[j].Offset
+	})
+	return nearby
+}
+
+// segments returns the route's geometry as straight segments in travel order
+func (r *Route) segments() []routeSegment {
+	var segments []routeSegment
+	offset := 0.0
+	for i := range r.PartialRoutes {
+		partial := &r.PartialRoutes[i]
+
+		var points [][2]float64
+		if partial.MapDataIndex != nil && *partial.MapDataIndex >= 0 && *partial.MapDataIndex < len(r.MapData) {
+			points = decodeMapDataPoints(r.MapData[*partial.MapDataIndex])
+		}
+		if len(points) < 2 {
+			points = points[:0]
+			for _, stop := range partial.RegularStops {
+				if stop.Latitude != 0 && stop.Longitude != 0 {
+					points = append(points, [2]float64{float64(stop.Latitude), float64(stop.Longitude)})
+				}
+			}
+		}
+
+		for j := 1; j < len(points); j++ {
+			segments = append(segments, routeSegment{partial: i, from: points[j-1], to: points[j], offset: offset})
+			offset += math.Hypot(points[j][0]-points[j-1][0], points[j][1]-points[j-1][1])
+		}
+	}
+	return segments
+}
+
+// decodeMapDataPoints decodes a MapData entry into Gauss-Krüger coordinates,
+// skipping the entry if it is malformed
+func decodeMapDataPoints(mapData string) [][2]float64 {
+	segment, err := DecodeMapData(mapData)
+	if err != nil || len(segment.Coordinates) == 0 {
+		return nil
+	}
+
+	points := make([][2]float64, 0, len(segment.Coordinates))
+	for _, c := range segment.Coordinates {
+		points = append(points, [2]float64{c.X, c.Y})
+	}
+	return points
+}
+
+// distanceToSegment returns the distance between p and the segment from a to b,
+// and the distance from a to the closest point on the segment
+func distanceToSegment(p, a, b [2]float64) (distance, along float64) {
+	dx, dy := b[0]-a[0], b[1]-a[1]
+	length := math.Hypot(dx, dy)
+	if length == 0 {
+		return math.Hypot(p[0]-a[0], p[1]-a[1]), 0
+	}
+
+	t := ((p[0]-a[0])*dx + (p[1]-a[1])*dy) / (length * length)
+	t = min(max(t, 0), 1)
+	closest := [2]float64{a[0] + t*dx, a[1] + t*dy}
+	return math.Hypot(p[0]-closest[0], p[1]-closest[1]), t * length
+}
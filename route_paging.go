@@ -0,0 +1,132 @@
+package dvb
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// GetEarlierRoutes retrieves the connections departing before those of a previous
+// GetRoute response, continuing its planning session. This function is used for
+// "show earlier connections" without re-planning from scratch.
+//
+// Parameters:
+//   - ctx: Context for the request, allowing for cancellation and timeouts
+//   - sessionId: The SessionId of a GetRoute response. This is required and cannot be empty.
+//   - requestOpts: Optional per-request overrides like headers or a timeout, see RequestOption
+//
+// Returns:
+//   - *GetRouteResponse: Contains the earlier routes, which can be paged further
+//   - error: Returns an error if the session ID is empty or if the API request fails.
+//     A *NotFoundError wrapping ErrNoRoute is returned if there are no earlier routes.
+//     A *StatusError wrapping ErrValidation is returned if the session has expired.
+//
+// Example usage:
+//
+//	response, err := client.GetRoute(ctx, params)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	earlier, err := client.GetEarlierRoutes(ctx, response.SessionId)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (c *Client) GetEarlierRoutes(ctx context.Context, sessionId string, requestOpts ...RequestOption) (*GetRouteResponse, error) {
+	return c.pageRoutes(ctx, sessionId, true, nil, requestOpts)
+}
+
+// GetLaterRoutes retrieves the connections departing after those of a previous
+// GetRoute response, continuing its planning session. This function is used for
+// "show later connections" without re-planning from scratch.
+//
+// Parameters:
+//   - ctx: Context for the request, allowing for cancellation and timeouts
+//   - sessionId: The SessionId of a GetRoute response. This is required and cannot be empty.
+//   - requestOpts: Optional per-request overrides like headers or a timeout, see RequestOption
+//
+// Returns:
+//   - *GetRouteResponse: Contains the later routes, which can be paged further
+//   - error: Returns an error if the session ID is empty or if the API request fails.
+//     A *NotFoundError wrapping ErrNoRoute is returned if there are no later routes.
+//     A *StatusError wrapping ErrValidation is returned if the session has expired.
+//
+// Example usage:
+//
+//	response, err := client.GetRoute(ctx, params)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	later, err := client.GetLaterRoutes(ctx, response.SessionId)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, route := range later.Routes {
+//		fmt.Println(route.Duration, route.Interchanges)
+//	}
+func (c *Client) GetLaterRoutes(ctx context.Context, sessionId string, requestOpts ...RequestOption) (*GetRouteResponse, error) {
+	return c.pageRoutes(ctx, sessionId, false, nil, requestOpts)
+}
+
+// Earlier retrieves the connections departing before these routes, see GetEarlierRoutes.
+// Unlike GetEarlierRoutes, the returned response keeps the parameters of the
+// original request, so it can be refreshed or followed with NewRouteSession.
+func (r *GetRouteResponse) Earlier(ctx context.Context) (*GetRouteResponse, error) {
+	if r.client == nil {
+		return nil, errNotRefreshable
+	}
+	return r.client.pageRoutes(ctx, r.SessionId, true, r.params, nil)
+}
+
+// Later retrieves the connections departing after these routes, see GetLaterRoutes.
+// Unlike GetLaterRoutes, the returned response keeps the parameters of the
+// original request, so it can be refreshed or followed with NewRouteSession.
+func (r *GetRouteResponse) Later(ctx context.Context) (*GetRouteResponse, error) {
+	if r.client == nil {
+		return nil, errNotRefreshable
+	}
+	return r.client.pageRoutes(ctx, r.SessionId, false, r.params, nil)
+}
+
+// pageRoutes requests the previous or next page of routes of a planning session.
+// params are the parameters of the request that started the session, if known.
+func (c *Client) pageRoutes(ctx context.Context, sessionId string, previous bool, params *GetRouteParams, requestOpts []RequestOption) (*GetRouteResponse, error) {
+	ctx, cancel := withRequestOptions(ctx, requestOpts)
+	defer cancel()
+
+	if sessionId == "" {
+		return nil, errors.New("sessionid can not be empty")
+	}
+
+	query := url.Values{}
+	query.Set("sessionId", sessionId)
+	query.Set("previous", strconv.FormatBool(previous))
+
+	opts := requestOptions{
+		Method: http.MethodGet,
+		Path:   "/tr/prevnext",
+		Query:  query,
+	}
+
+	resp, err := c.doRequest(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var resource GetRouteResponse
+	if err := c.handleResponse(resp, &resource); err != nil {
+		return nil, err
+	}
+
+	if len(resource.Routes) == 0 {
+		return nil, &NotFoundError{Err: ErrNoRoute, Query: sessionId, Status: resource.Status}
+	}
+
+	// Keep paging within the session if the API does not repeat the session ID
+	if resource.SessionId == "" {
+		resource.SessionId = sessionId
+	}
+	resource.client, resource.params = c, params
+	return &resource, nil
+}
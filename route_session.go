@@ -0,0 +1,166 @@
+package dvb
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RouteSession follows a route the user selected from a GetRoute response. Update
+// re-queries the planner within the same planning session and picks out that
+// specific connection, refreshing its real-time times and changeover risk
+// without re-planning from scratch.
+//
+// Example usage:
+//
+//	response, err := client.GetRoute(ctx, params)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	session, err := client.NewRouteSession(response, response.Routes[0].RouteId)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	// ... later
+//	route, err := session.Update(ctx)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, interchange := range route.InterchangeDetails() {
+//		fmt.Println(interchange.To.Name, interchange.Buffer, interchange.Endangered)
+//	}
+type RouteSession struct {
+	// SessionId is the planning session the route was found in
+	SessionId string
+
+	// RouteId identifies the selected route within the session
+	RouteId int
+
+	client    *Client
+	params    GetRouteParams
+	signature string
+
+	mu    sync.Mutex
+	route Route
+}
+
+// NewRouteSession creates a RouteSession for the route with the given RouteId
+// from a GetRoute response. Returns an error if the response contains no such route
+// or was not produced by a client.
+func (c *Client) NewRouteSession(response *GetRouteResponse, routeId int) (*RouteSession, error) {
+	if response.params == nil {
+		return nil, errNotRefreshable
+	}
+
+	for _, route := range response.Routes {
+		if route.RouteId != routeId {
+			continue
+		}
+		if route.Synthesized {
+			return nil, errors.New("synthesized routes can not be followed")
+		}
+
+		params := *response.params
+		shortTermChanges := true
+		params.ShortTermChanges = &shortTermChanges
+		params.WalkingFallback = nil
+		params.SessionId = &response.SessionId
+
+		// Pin the query to the route's scheduled departure so the connection is
+		// still part of the results after time has passed
+		if departure := route.scheduledDeparture(); !departure.IsZero() {
+			isArrivalTime := false
+			params.Time = &departure
+			params.IsArrivalTime = &isArrivalTime
+		}
+
+		return &RouteSession{
+			SessionId: response.SessionId,
+			RouteId:   routeId,
+			client:    c,
+			params:    params,
+			signature: route.signature(),
+			route:     route,
+		}, nil
+	}
+
+	return nil, &NotFoundError{Err: ErrNoRoute, Query: "route " + strconv.Itoa(routeId)}
+}
+
+// Route returns the most recent state of the followed route
+func (s *RouteSession) Route() Route {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.route
+}
+
+// Update re-queries the planner and returns the current state of the followed
+// route with up-to-date real-time data. A *NotFoundError wrapping ErrNoRoute is
+// returned if the connection is no longer offered, e.g. because it was cancelled.
+func (s *RouteSession) Update(ctx context.Context) (*Route, error) {
+	response, err := s.client.GetRoute(ctx, &s.params)
+	if err != nil {
+		return nil, err
+	}
+
+	// Route IDs are only positions within a response, so match on the
+	// connection itself and use the ID only to prefer the same position
+	var match *Route
+	for i := range response.Routes {
+		route := &response.Routes[i]
+		if route.signature() != s.signature {
+			continue
+		}
+		if match == nil || route.RouteId == s.RouteId {
+			match = route
+		}
+	}
+	if match == nil {
+		return nil, &NotFoundError{Err: ErrNoRoute, Query: "route " + strconv.Itoa(s.RouteId)}
+	}
+
+	s.mu.Lock()
+	s.route = *match
+	s.mu.Unlock()
+
+	route := *match
+	return &route, nil
+}
+
+// signature identifies a connection independently of real-time data by the
+// line, boarding stop and scheduled departure of each transit segment
+func (r *Route) signature() string {
+	var b strings.Builder
+	for i := range r.PartialRoutes {
+		partial := &r.PartialRoutes[i]
+		if !partial.isTransit() {
+			continue
+		}
+		if partial.Mot.DlId != nil {
+			b.WriteString(*partial.Mot.DlId)
+		} else if partial.Mot.Name != nil {
+			b.WriteString(*partial.Mot.Name)
+		}
+		b.WriteByte('|')
+		if stop := partial.BoardingStop(); stop != nil {
+			b.WriteString(stop.DataId)
+			b.WriteByte('|')
+			b.WriteString(strconv.FormatInt(stop.DepartureTime.UnixMilli(), 10))
+		}
+		b.WriteByte(';')
+	}
+	return b.String()
+}
+
+// scheduledDeparture returns the scheduled departure at the route's first stop
+func (r *Route) scheduledDeparture() time.Time {
+	for _, partial := range r.PartialRoutes {
+		if len(partial.RegularStops) > 0 {
+			return partial.RegularStops[0].DepartureTime.Time
+		}
+	}
+	return time.Time{}
+}
@@ -0,0 +1,109 @@
+package dvb
+
+import (
+	"net/url"
+	"strconv"
+)
+
+// RouteSettings contains advanced routing preferences. If set in GetRouteParams,
+// the trip request is sent as POST with a JSON body, as the API only accepts
+// these settings in the request body.
+type RouteSettings struct {
+	// Standard contains the general routing preferences. Optional parameter.
+	Standard *StandardSettings
+
+	// Mobility contains the accessibility preferences. Optional parameter.
+	Mobility *MobilitySettings
+}
+
+// StandardSettings contains the general routing preferences of a trip request.
+// Unset fields use the API's defaults.
+type StandardSettings struct {
+	// MaxChanges limits the number of transfers. Supported values are
+	// "Unlimited", "Two", "One" and "None".
+	MaxChanges *string `json:"maxChanges,omitempty"`
+
+	// WalkingSpeed is the assumed walking speed for footpaths and transfers.
+	// Supported values are "VerySlow", "Slow", "Normal", "Fast" and "VeryFast".
+	WalkingSpeed *string `json:"walkingSpeed,omitempty"`
+
+	// FootpathToStop is the maximum walking time to the first and from the last stop, in minutes
+	FootpathToStop *int `json:"footpathToStop,omitempty"`
+
+	// Mot restricts the modes of transport (e.g., MotTram, MotCityBus, MotSuburbanRailway).
+	// All modes are allowed if empty.
+	Mot []MotType `json:"mot,omitempty"`
+
+	// IncludeAlternativeStops allows starting and ending at nearby stops
+	IncludeAlternativeStops *bool `json:"includeAlternativeStops,omitempty"`
+
+	// ExtraCharge controls connections requiring a surcharge. Supported values are
+	// "None", "LocalTraffic" and "LocalTrafficAndLongDistance".
+	ExtraCharge *string `json:"extraCharge,omitempty"`
+}
+
+// MobilitySettings contains the accessibility preferences of a trip request.
+// Unset fields use the API's defaults.
+type MobilitySettings struct {
+	// MobilityRestriction selects a predefined accessibility profile. Supported values
+	// are "None", "Medium", "High" and "Individual"; the remaining fields only apply
+	// to "Individual".
+	MobilityRestriction *string `json:"mobilityRestriction,omitempty"`
+
+	// SolidStairs allows stairs on footpaths and transfers
+	SolidStairs *bool `json:"solidStairs,omitempty"`
+
+	// Escalators allows escalators on footpaths and transfers
+	Escalators *bool `json:"escalators,omitempty"`
+
+	// LeastChange prefers connections with fewer transfers
+	LeastChange *bool `json:"leastChange,omitempty"`
+
+	// Entrance is the required vehicle entrance. Supported values are "Any",
+	// "SmallStep" and "NoStep".
+	Entrance *string `json:"entrance,omitempty"`
+}
+
+// tripRequest is the JSON body of a POST trip request
+type tripRequest struct {
+	Origin           string            `json:"origin"`
+	Destination      string            `json:"destination"`
+	Format           string            `json:"format,omitempty"`
+	IsArrivalTime    *bool             `json:"isarrivaltime,omitempty"`
+	ShortTermChanges *bool             `json:"shorttermchanges,omitempty"`
+	Time             string            `json:"time,omitempty"`
+	Via              string            `json:"via,omitempty"`
+	DwellTime        *int              `json:"dwelltime,omitempty"`
+	SessionId        string            `json:"sessionId,omitempty"`
+	StandardSettings *StandardSettings `json:"standardSettings,omitempty"`
+	MobilitySettings *MobilitySettings `json:"mobilitySettings,omitempty"`
+}
+
+// newTripRequest builds the POST body from the query parameters of a trip request
+// and the settings
+func newTripRequest(query url.Values, settings *RouteSettings) tripRequest {
+	get := query.Get
+	parseBool := func(key string) *bool {
+		if value, err := strconv.ParseBool(get(key)); err == nil {
+			return &value
+		}
+		return nil
+	}
+
+	request := tripRequest{
+		Origin:           get("origin"),
+		Destination:      get("destination"),
+		Format:           get("format"),
+		IsArrivalTime:    parseBool("isarrivaltime"),
+		ShortTermChanges: parseBool("shorttermchanges"),
+		Time:             get("time"),
+		Via:              get("via"),
+		SessionId:        get("sessionId"),
+		StandardSettings: settings.Standard,
+		MobilitySettings: settings.Mobility,
+	}
+	if dwellTime, err := strconv.Atoi(get("dwelltime")); err == nil {
+		request.DwellTime = &dwellTime
+	}
+	return request
+}
@@ -0,0 +1,140 @@
+package dvb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// Via is an intermediate stop of a journey, see GetRouteParams.Vias
+type Via struct {
+	// StopId is the stop ID or name of the intermediate stop. This is required and cannot be empty.
+	StopId string
+
+	// DwellTime is how long to stay at the stop, e.g. for an errand. It is rounded up
+	// to whole minutes for the API. Optional parameter.
+	DwellTime time.Duration
+}
+
+// dwellMinutes returns the dwell time in whole minutes as sent to the API, rounded
+// up so the stay is never shorter than requested, or nil if there is no dwell time
+func (v Via) dwellMinutes() *int {
+	if v.DwellTime <= 0 {
+		return nil
+	}
+	return Ptr(int(math.Ceil(v.DwellTime.Minutes())))
+}
+
+// routeVias plans a journey through multiple via stops as consecutive segments.
+// Departure times are planned forwards from the origin, arrival times backwards
+// from the destination. Each segment uses the earliest-arriving (or latest-departing)
+// connection, and the segments are joined into a single synthesized route with
+// the dwell times as StayForConnection segments.
+func (c *Client) routeVias(ctx context.Context, options *GetRouteParams) (*GetRouteResponse, error) {
+	if options.Via != nil && *options.Via != "" {
+		return nil, errors.New("via and vias can not be combined")
+	}
+
+	stops := make([]string, 0, len(options.Vias)+2)
+	stops = append(stops, options.Origin)
+	for _, via := range options.Vias {
+		if via.StopId == "" {
+			return nil, errors.New("via stop id can not be empty")
+		}
+		stops = append(stops, via.StopId)
+	}
+	stops = append(stops, options.Destination)
+
+	arrival := options.IsArrivalTime != nil && *options.IsArrivalTime
+	at := options.Time
+
+	segments := make([]Route, len(stops)-1)
+	for n := range segments {
+		i := n
+		if arrival {
+			i = len(segments) - 1 - n
+		}
+
+		params := *options
+		params.Origin, params.Destination = stops[i], stops[i+1]
+		params.Time = at
+		params.Via, params.ViaDwellTime, params.Vias, params.SessionId = nil, nil, nil, nil
+
+		response, err := c.GetRoute(ctx, &params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to plan %s → %s: %w", stops[i], stops[i+1], err)
+		}
+		segment := bestSegment(response.Routes, arrival)
+		segments[i] = *segment
+
+		// The next segment departs after the dwell time at the via stop, the
+		// previous one (when planning backwards) arrives before it
+		var next time.Time
+		if arrival {
+			if i > 0 {
+				next = segment.DepartureTime().Add(-options.Vias[i-1].DwellTime)
+			}
+		} else if i < len(options.Vias) {
+			next = segment.ArrivalTime().Add(options.Vias[i].DwellTime)
+		}
+		if !next.IsZero() {
+			at = TimePtr(next)
+		}
+	}
+
+	route := joinSegments(segments)
+	return &GetRouteResponse{
+		Status: Status{Code: statusOk},
+		Routes: []Route{route},
+		client: c,
+		params: options,
+	}, nil
+}
+
+// bestSegment returns the earliest-arriving route, or the latest-departing one
+// when planning by arrival time
+func bestSegment(routes []Route, arrival bool) *Route {
+	best := &routes[0]
+	for i := range routes[1:] {
+		route := &routes[i+1]
+		if arrival && route.DepartureTime().After(best.DepartureTime()) ||
+			!arrival && route.ArrivalTime().Before(best.ArrivalTime()) {
+			best = route
+		}
+	}
+	return best
+}
+
+// joinSegments joins the routes of consecutive segments into a single route,
+// separated by StayForConnection segments for the time spent at the via stops
+func joinSegments(segments []Route) Route {
+	route := Route{RouteId: 1, Synthesized: true}
+	for i, segment := range segments {
+		if i > 0 {
+			dwell := segments[i].DepartureTime().Sub(segments[i-1].ArrivalTime())
+			route.PartialRoutes = append(route.PartialRoutes, PartialRoute{
+				Duration: max(int(math.Round(dwell.Minutes())), 0),
+				Mot:      Mot{Type: MotStayForConnection},
+			})
+		}
+
+		offset := len(route.MapData)
+		for _, partial := range segment.PartialRoutes {
+			if partial.MapDataIndex != nil {
+				index := *partial.MapDataIndex + offset
+				partial.MapDataIndex = &index
+			}
+			route.PartialRoutes = append(route.PartialRoutes, partial)
+		}
+		route.MapData = append(route.MapData, segment.MapData...)
+		route.MotChain = append(route.MotChain, segment.MotChain...)
+		route.Interchanges += segment.Interchanges
+	}
+
+	if departure, arrival := route.DepartureTime(), route.ArrivalTime(); !departure.IsZero() && !arrival.IsZero() {
+		route.Duration = int(math.Round(arrival.Sub(departure).Minutes()))
+	}
+	return route
+}
@@ -0,0 +1,81 @@
+package dvb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+)
+
+const (
+	// walkingSpeed is the assumed average walking speed in meters per second (~4.3 km/h)
+	walkingSpeed = 1.2
+
+	// walkingDetourFactor accounts for streets not following the straight line between two points
+	walkingDetourFactor = 1.3
+)
+
+// errNoCoordinates is returned when a point has no coordinates
+var errNoCoordinates = errors.New("point has no coordinates")
+
+// walkingRoute synthesizes a walking-only route between origin and destination.
+// Both endpoints are resolved via the point finder to obtain their coordinates,
+// and the duration is estimated from the straight-line distance between them.
+func (c *Client) walkingRoute(ctx context.Context, origin, destination string) (*Route, error) {
+	from, err := c.locateStop(ctx, origin)
+	if err != nil {
+		return nil, err
+	}
+	to, err := c.locateStop(ctx, destination)
+	if err != nil {
+		return nil, err
+	}
+
+	distance := math.Hypot(float64(to.Latitude-from.Latitude), float64(to.Longitude-from.Longitude))
+	duration := int(math.Ceil(distance * walkingDetourFactor / walkingSpeed / 60))
+	if duration < 1 {
+		duration = 1
+	}
+
+	mapDataIndex := 0
+	mapData := fmt.Sprintf("%s|%d|%d|%d|%d|", MotFootpath, from.Latitude, from.Longitude, to.Latitude, to.Longitude)
+
+	return &Route{
+		Duration: duration,
+		MotChain: []MotChain{{Type: MotFootpath}},
+		PartialRoutes: []PartialRoute{{
+			Duration:     duration,
+			Mot:          Mot{Type: MotFootpath},
+			MapDataIndex: &mapDataIndex,
+			RegularStops: []RegularStop{*from, *to},
+		}},
+		MapData:     []string{mapData},
+		Synthesized: true,
+	}, nil
+}
+
+// locateStop resolves a stop ID or name to a RegularStop carrying its name and coordinates
+func (c *Client) locateStop(ctx context.Context, query string) (*RegularStop, error) {
+	limit := 1
+	response, err := c.GetPoint(ctx, &GetPointParams{Query: query, Limit: &limit})
+	if err != nil {
+		return nil, err
+	}
+	if len(response.Points) == 0 {
+		return nil, fmt.Errorf("no point found for %q", query)
+	}
+
+	point := response.Points[0]
+	if point.X == 0 || point.Y == 0 {
+		return nil, errNoCoordinates
+	}
+
+	return &RegularStop{
+		DataId:    point.Id,
+		Place:     point.Place,
+		Name:      point.Name,
+		Type:      "Stop",
+		Latitude:  point.X,
+		Longitude: point.Y,
+	}, nil
+}
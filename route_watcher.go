@@ -0,0 +1,393 @@
+package dvb
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// RouteEventKind classifies the changes detected while watching a journey
+type RouteEventKind string
+
+const (
+	LegDelayed         RouteEventKind = "leg-delayed"         // The delay of a transit leg changed by at least the threshold
+	TransferEndangered RouteEventKind = "transfer-endangered" // A transfer can no longer be reached safely
+	RouteInfeasible    RouteEventKind = "route-infeasible"    // The connection is no longer offered, e.g. it was cancelled
+)
+
+// RouteWatcherParams contains the parameters for watching a planned journey.
+// Either Response or Origin and Destination are required.
+type RouteWatcherParams struct {
+	// Response and RouteId select the route to watch from a GetRoute response
+	Response *GetRouteResponse
+	RouteId  int
+
+	// Origin, Destination and Time plan the journey if Response is nil. The first
+	// route departing at or after Time (optional, defaults to now) is watched.
+	Origin      string
+	Destination string
+	Time        *time.Time
+
+	// Interval is the time between two checks (optional, defaults to 60s)
+	Interval time.Duration
+
+	// DelayThreshold is the minimum change of a leg's delay that is reported
+	// (optional, defaults to 2m)
+	DelayThreshold time.Duration
+}
+
+// RouteEvent is a single change of a watched journey
+type RouteEvent struct {
+	Kind RouteEventKind
+
+	// Route is the current state of the journey, or the last known state for
+	// RouteInfeasible events
+	Route Route
+
+	// Leg is the index into Route.PartialRoutes of the delayed leg (LegDelayed only)
+	Leg int
+
+	// OldDelay and NewDelay are the previously reported and the current delay
+	// of the leg (LegDelayed only)
+	OldDelay time.Duration
+	NewDelay time.Duration
+
+	// Interchange is the endangered transfer (TransferEndangered only)
+	Interchange *Interchange
+
+	// Replacement is the earliest-arriving alternative from the point where the
+	// journey breaks to the destination, or nil if none was found
+	// (TransferEndangered and RouteInfeasible only)
+	Replacement *Route
+
+	// Err is the reason the route is infeasible (RouteInfeasible only)
+	Err error
+}
+
+// RouteWatcher periodically re-checks a planned journey using a RouteSession and
+// reports delayed legs, endangered transfers and cancelled connections together
+// with a suggested replacement route. Polling ends automatically once the
+// journey has arrived.
+//
+// Example usage:
+//
+//	watcher, err := client.NewRouteWatcher(RouteWatcherParams{
+//		Origin:      "33000028",
+//		Destination: "33000016",
+//	})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	if err := watcher.Start(ctx); err != nil {
+//		log.Fatal(err)
+//	}
+//	defer watcher.Stop()
+//	for event := range watcher.Events() {
+//		fmt.Println(event.Kind, event.NewDelay)
+//	}
+type RouteWatcher struct {
+	client *Client
+	params RouteWatcherParams
+	events chan RouteEvent
+
+	// updateMu serializes checks, as the reported state is compared between them
+	updateMu   sync.Mutex
+	session    *RouteSession
+	delays     map[int]time.Duration
+	endangered map[int]bool
+	infeasible bool
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// routeArrivalGrace is how long after the planned arrival a journey is still watched
+const routeArrivalGrace = 5 * time.Minute
+
+// NewRouteWatcher creates a RouteWatcher. Call Start to begin polling or Update
+// to check the journey once.
+func (c *Client) NewRouteWatcher(params RouteWatcherParams) (*RouteWatcher, error) {
+	if params.Response == nil {
+		if params.Origin == "" {
+			return nil, errors.New("origin can not be empty")
+		}
+		if params.Destination == "" {
+			return nil, errors.New("destination can not be empty")
+		}
+	}
+	if params.Interval <= 0 {
+		params.Interval = 60 * time.Second
+	}
+	if params.DelayThreshold <= 0 {
+		params.DelayThreshold = 2 * time.Minute
+	}
+
+	watcher := &RouteWatcher{
+		client:     c,
+		params:     params,
+		events:     make(chan RouteEvent, 16),
+		delays:     make(map[int]time.Duration),
+		endangered: make(map[int]bool),
+	}
+	if params.Response != nil {
+		session, err := c.NewRouteSession(params.Response, params.RouteId)
+		if err != nil {
+			return nil, err
+		}
+		watcher.session = session
+		watcher.record(session.Route())
+	}
+	return watcher, nil
+}
+
+// Events returns the channel receiving the events detected by the background poller
+func (w *RouteWatcher) Events() <-chan RouteEvent {
+	return w.events
+}
+
+// Route returns the most recent state of the watched journey, or the zero Route
+// if it was not planned yet
+func (w *RouteWatcher) Route() Route {
+	w.updateMu.Lock()
+	defer w.updateMu.Unlock()
+
+	if w.session == nil {
+		return Route{}
+	}
+	return w.session.Route()
+}
+
+// Start begins polling in the background until Stop is called, ctx is cancelled
+// or the journey has arrived
+func (w *RouteWatcher) Start(ctx context.Context) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.cancel != nil {
+		return errors.New("watcher already running")
+	}
+
+	ctx, w.cancel = context.WithCancel(ctx)
+	w.done = make(chan struct{})
+	w.client.metrics.PollerState(w.name(), true)
+	go w.run(ctx, w.done)
+	return nil
+}
+
+// Stop ends polling and waits for the background goroutine to exit
+func (w *RouteWatcher) Stop() {
+	w.mu.Lock()
+	cancel, done := w.cancel, w.done
+	w.cancel, w.done = nil, nil
+	w.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+		<-done
+	}
+}
+
+// name identifies the watcher in metrics
+func (w *RouteWatcher) name() string {
+	origin, destination := w.params.Origin, w.params.Destination
+	if w.params.Response != nil && w.params.Response.params != nil {
+		origin, destination = w.params.Response.params.Origin, w.params.Response.params.Destination
+	}
+	return "route:" + origin + "→" + destination
+}
+
+func (w *RouteWatcher) run(ctx context.Context, done chan struct{}) {
+	defer close(done)
+	defer w.client.metrics.PollerState(w.name(), false)
+
+	ticker := time.NewTicker(w.params.Interval)
+	defer ticker.Stop()
+
+	for {
+		events, _ := w.Update(ctx)
+		for _, event := range events {
+			select {
+			case w.events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		route := w.Route()
+		if arrival := route.ArrivalTime(); !arrival.IsZero() && time.Since(arrival) > routeArrivalGrace {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Update checks the journey once and returns the changes since the previous check.
+// The first call plans the journey if no Response was given. A route that is no
+// longer offered is reported as a RouteInfeasible event, not as an error; errors
+// are returned if the API could not be queried.
+func (w *RouteWatcher) Update(ctx context.Context) ([]RouteEvent, error) {
+	w.updateMu.Lock()
+	defer w.updateMu.Unlock()
+
+	if w.session == nil {
+		session, err := w.plan(ctx)
+		if err != nil {
+			return nil, err
+		}
+		w.session = session
+		w.record(session.Route())
+		return nil, nil
+	}
+
+	route, err := w.session.Update(ctx)
+	if errors.Is(err, ErrNoRoute) {
+		if w.infeasible {
+			return nil, nil
+		}
+		w.infeasible = true
+
+		last := w.session.Route()
+		event := RouteEvent{Kind: RouteInfeasible, Route: last, Err: err}
+		origin, at := w.position(&last, time.Now())
+		event.Replacement = w.replacement(ctx, origin, at)
+		return []RouteEvent{event}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	w.infeasible = false
+
+	var events []RouteEvent
+	now := time.Now()
+	for i := range route.PartialRoutes {
+		partial := &route.PartialRoutes[i]
+		if !partial.isTransit() || legArrival(partial).Before(now) {
+			continue
+		}
+		delay := legDelay(partial)
+		if old := w.delays[i]; (delay - old).Abs() >= w.params.DelayThreshold {
+			events = append(events, RouteEvent{Kind: LegDelayed, Route: *route, Leg: i, OldDelay: old, NewDelay: delay})
+			w.delays[i] = delay
+		}
+	}
+
+	for i, interchange := range route.InterchangeDetails() {
+		if interchange.Endangered && !w.endangered[i] {
+			event := RouteEvent{Kind: TransferEndangered, Route: *route, Interchange: &interchange}
+			at := firstDate(interchange.From.ArrivalRealTime, &interchange.From.ArrivalTime)
+			event.Replacement = w.replacement(ctx, interchange.From.DataId, at)
+			events = append(events, event)
+		}
+		w.endangered[i] = interchange.Endangered
+	}
+
+	return events, nil
+}
+
+// plan queries the planner for the journey and follows its first route
+func (w *RouteWatcher) plan(ctx context.Context) (*RouteSession, error) {
+	params := &GetRouteParams{
+		Origin:           w.params.Origin,
+		Destination:      w.params.Destination,
+		ShortTermChanges: Bool(true),
+	}
+	params.Time = w.params.Time
+
+	response, err := w.client.GetRoute(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	for _, route := range response.Routes {
+		if !route.Synthesized {
+			return w.client.NewRouteSession(response, route.RouteId)
+		}
+	}
+	return nil, &NotFoundError{Err: ErrNoRoute, Query: params.Origin + " → " + params.Destination}
+}
+
+// record stores the delays and transfer states of the initial route, so only
+// changes are reported
+func (w *RouteWatcher) record(route Route) {
+	for i := range route.PartialRoutes {
+		if partial := &route.PartialRoutes[i]; partial.isTransit() {
+			w.delays[i] = legDelay(partial)
+		}
+	}
+	for i, interchange := range route.InterchangeDetails() {
+		w.endangered[i] = interchange.Endangered
+	}
+}
+
+// position returns where the traveller is expected to be at now: the origin
+// before the journey starts, otherwise the alighting stop of the last leg
+// that has arrived
+func (w *RouteWatcher) position(route *Route, now time.Time) (string, time.Time) {
+	origin, at := w.session.params.Origin, route.DepartureTime()
+	for i := range route.PartialRoutes {
+		partial := &route.PartialRoutes[i]
+		if !partial.isTransit() || legArrival(partial).After(now) {
+			continue
+		}
+		origin, at = partial.AlightingStop().DataId, legArrival(partial)
+	}
+	if at.Before(now) {
+		at = now
+	}
+	return origin, at
+}
+
+// replacement plans the earliest-arriving route from origin to the destination
+// departing at or after at. Returns nil if none could be found.
+func (w *RouteWatcher) replacement(ctx context.Context, origin string, at time.Time) *Route {
+	if origin == "" {
+		return nil
+	}
+
+	response, err := w.client.GetRoute(ctx, &GetRouteParams{
+		Origin:           origin,
+		Destination:      w.session.params.Destination,
+		Time:             &at,
+		ShortTermChanges: Bool(true),
+	})
+	if err != nil {
+		return nil
+	}
+
+	var best *Route
+	for i := range response.Routes {
+		route := &response.Routes[i]
+		if route.signature() == w.session.signature {
+			continue
+		}
+		if best == nil || route.ArrivalTime().Before(best.ArrivalTime()) {
+			best = route
+		}
+	}
+	return best
+}
+
+// legDelay returns the real-time delay of a transit leg, taking the larger of
+// the departure delay at the boarding stop and the arrival delay at the alighting stop
+func legDelay(partial *PartialRoute) time.Duration {
+	var delay time.Duration
+	if stop := partial.BoardingStop(); stop != nil && stop.DepartureRealTime != nil && !stop.DepartureTime.IsZero() {
+		delay = stop.DepartureRealTime.Sub(stop.DepartureTime.Time)
+	}
+	if stop := partial.AlightingStop(); stop != nil && stop.ArrivalRealTime != nil && !stop.ArrivalTime.IsZero() {
+		delay = max(delay, stop.ArrivalRealTime.Sub(stop.ArrivalTime.Time))
+	}
+	return delay
+}
+
+// legArrival returns the expected arrival of a transit leg at its alighting stop
+func legArrival(partial *PartialRoute) time.Time {
+	stop := partial.AlightingStop()
+	return firstDate(stop.ArrivalRealTime, &stop.ArrivalTime)
+}
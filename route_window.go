@@ -0,0 +1,152 @@
+package dvb
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+)
+
+// GetRouteWindowParams contains the parameters for planning all connections
+// departing within a time window.
+type GetRouteWindowParams struct {
+	// Route contains the trip parameters. Origin and Destination are required;
+	// Time and IsArrivalTime are ignored in favor of From.
+	Route GetRouteParams
+
+	// From is the start of the departure window. Optional parameter, defaults to now.
+	From *time.Time
+
+	// Window is the length of the departure window. Optional parameter, defaults to 1 hour.
+	Window *time.Duration
+
+	// MaxRequests limits the number of planner queries used to fill the window.
+	// Optional parameter, defaults to 8.
+	MaxRequests *int
+}
+
+// GetRouteWindow returns all connections departing within a time window, e.g. the
+// next hour, rather than just the few around a single timestamp. It pages through
+// the planner by querying again after the last departure found, and combines the
+// results into one response sorted by departure time with duplicates removed.
+//
+// Parameters:
+//   - ctx: Context for the request, allowing for cancellation and timeouts
+//   - options: The trip parameters and the departure window
+//   - requestOpts: Optional per-request overrides like headers or a timeout, see RequestOption
+//
+// Returns:
+//   - *GetRouteResponse: Contains the connections departing within the window, with
+//     RouteIds renumbered in departure order and the SessionId of the last query
+//   - error: Returns an error if the parameters are invalid or the first planner
+//     query fails. A *NotFoundError wrapping ErrNoRoute is returned if no connection
+//     departs within the window.
+//
+// Example usage:
+//
+//	window := time.Hour
+//	response, err := client.GetRouteWindow(ctx, &GetRouteWindowParams{
+//		Route:  GetRouteParams{Origin: "33000028", Destination: "33000016"},
+//		Window: &window,
+//	})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, route := range response.Routes {
+//		fmt.Println(route.DepartureTime().Format("15:04"), route.Duration)
+//	}
+func (c *Client) GetRouteWindow(ctx context.Context, options *GetRouteWindowParams, requestOpts ...RequestOption) (*GetRouteResponse, error) {
+	ctx, cancel := withRequestOptions(ctx, requestOpts)
+	defer cancel()
+
+	if options == nil {
+		return nil, errors.New("options can not be nil")
+	}
+
+	from := time.Now()
+	if options.From != nil {
+		from = *options.From
+	}
+	window := time.Hour
+	if options.Window != nil {
+		window = *options.Window
+	}
+	if window <= 0 {
+		return nil, errors.New("window must be positive")
+	}
+	maxRequests := 8
+	if options.MaxRequests != nil && *options.MaxRequests > 0 {
+		maxRequests = *options.MaxRequests
+	}
+	until := from.Add(window)
+
+	params := options.Route
+	isArrivalTime := false
+	params.IsArrivalTime = &isArrivalTime
+
+	var (
+		combined GetRouteResponse
+		seen     = make(map[string]bool)
+		cursor   = from
+	)
+	for request := 0; request < maxRequests && cursor.Before(until); request++ {
+		params.Time = TimePtr(cursor)
+
+		response, err := c.GetRoute(ctx, &params)
+		if errors.Is(err, ErrNoRoute) {
+			break
+		}
+		if err != nil {
+			if request == 0 {
+				return nil, err
+			}
+			// Return what has been found so far rather than failing the whole window
+			break
+		}
+		combined.SessionId = response.SessionId
+		combined.Status = response.Status
+
+		latest := cursor
+		added := false
+		for _, route := range response.Routes {
+			departure := route.scheduledDeparture()
+			if departure.After(latest) {
+				latest = departure
+			}
+			if departure.Before(from) || !departure.Before(until) || route.Synthesized {
+				continue
+			}
+			if signature := route.signature(); !seen[signature] {
+				seen[signature] = true
+				combined.Routes = append(combined.Routes, route)
+				added = true
+			}
+		}
+
+		// Stop if the planner returns nothing new, as paging would not make progress
+		if !added && !latest.After(cursor) {
+			break
+		}
+		cursor = latest.Add(time.Minute)
+	}
+
+	if len(combined.Routes) == 0 {
+		return nil, &NotFoundError{
+			Err:    ErrNoRoute,
+			Query:  params.Origin + " → " + params.Destination,
+			Status: combined.Status,
+		}
+	}
+
+	sort.SliceStable(combined.Routes, func(i, j int) bool {
+		return combined.Routes[i].scheduledDeparture().Before(combined.Routes[j].scheduledDeparture())
+	})
+	for i := range combined.Routes {
+		combined.Routes[i].RouteId = i + 1
+	}
+
+	params.Time = nil
+	params.IsArrivalTime = nil
+	combined.client, combined.params = c, &params
+	return &combined, nil
+}
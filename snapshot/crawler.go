@@ -0,0 +1,152 @@
+package snapshot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/niclaszll/dvb-go"
+	"github.com/niclaszll/dvb-go/gtfs"
+)
+
+// Crawler fetches stops and their lines from the API into a Dataset.
+type Crawler struct {
+	// Client is used for all requests. This is required.
+	Client *dvb.Client
+
+	// Feed provides the stop IDs to crawl when no seeds are given and the stop
+	// sequences of the lines' directions (optional). Without it, directions only
+	// carry their names.
+	Feed *gtfs.Feed
+
+	// Bulk controls concurrency, rate limiting, progress reporting and checkpointing
+	Bulk dvb.BulkOptions
+
+	// MaxAge enables incremental refresh: stops of the previous dataset crawled
+	// more recently are kept as they are (optional, all stops are crawled if 0)
+	MaxAge time.Duration
+
+	// Errors is called for every stop that could not be crawled (optional).
+	// Failed stops are kept from the previous dataset if present.
+	Errors func(stopId string, err error)
+}
+
+// crawledStop is the result of crawling a single stop
+type crawledStop struct {
+	stop  *Stop
+	lines []dvb.Line
+}
+
+// Crawl crawls the given stop IDs, or all stations of the Feed if seeds is empty,
+// and merges the results into a copy of previous (which may be nil).
+func (c *Crawler) Crawl(ctx context.Context, seeds []string, previous *Dataset) (*Dataset, error) {
+	if c.Client == nil {
+		return nil, errors.New("client can not be nil")
+	}
+	if len(seeds) == 0 && c.Feed != nil {
+		seeds = c.Feed.APIStopIds()
+	}
+	if len(seeds) == 0 {
+		return nil, errors.New("no stops to crawl")
+	}
+
+	dataset := New()
+	if previous != nil {
+		for id, stop := range previous.Stops {
+			dataset.Stops[id] = stop
+		}
+		for key, line := range previous.Lines {
+			// Copy lines as their directions are extended while merging
+			copied := *line
+			copied.Directions = append([]Direction(nil), line.Directions...)
+			dataset.Lines[key] = &copied
+		}
+	}
+
+	now := time.Now()
+	var pending []string
+	for _, stopId := range seeds {
+		if stop, ok := dataset.Stops[stopId]; ok && c.MaxAge > 0 && now.Sub(stop.Crawled) < c.MaxAge {
+			continue
+		}
+		pending = append(pending, stopId)
+	}
+
+	err := dvb.RunBulk(ctx, pending, c.crawlStop, func(stopId string, result *crawledStop, err error) error {
+		if err != nil {
+			if c.Errors != nil {
+				c.Errors(stopId, err)
+			}
+			return nil
+		}
+
+		dataset.Stops[stopId] = result.stop
+		for _, line := range result.lines {
+			c.mergeLine(dataset, line)
+		}
+		return nil
+	}, c.Bulk)
+	if err != nil {
+		return nil, err
+	}
+
+	dataset.Created = now
+	return dataset, nil
+}
+
+// crawlStop fetches the details and lines of a single stop
+func (c *Crawler) crawlStop(ctx context.Context, stopId string) (*crawledStop, error) {
+	stop := &Stop{Id: stopId, Crawled: time.Now()}
+
+	points, err := c.Client.GetPoint(ctx, &dvb.GetPointParams{Query: stopId})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stop: %w", err)
+	}
+	for _, point := range points.Points.OnlyStops() {
+		if point.Id == stopId {
+			stop.Name, stop.Place, stop.X, stop.Y = point.Name, point.Place, point.X, point.Y
+			break
+		}
+	}
+
+	lines, err := c.Client.GetLines(ctx, &dvb.GetLinesParams{StopId: stopId})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lines: %w", err)
+	}
+	for _, line := range lines.Lines {
+		stop.Lines = append(stop.Lines, LineKey(line.Mot, line.Name))
+	}
+	sort.Strings(stop.Lines)
+
+	return &crawledStop{stop: stop, lines: lines.Lines}, nil
+}
+
+// mergeLine adds the line and its directions to the dataset
+func (c *Crawler) mergeLine(dataset *Dataset, line dvb.Line) {
+	key := LineKey(line.Mot, line.Name)
+	existing, ok := dataset.Lines[key]
+	if !ok {
+		existing = &Line{Name: line.Name, Mot: line.Mot, Diva: line.Diva.Number}
+		if c.Feed != nil {
+			for _, direction := range c.Feed.LineDirections(line.Name) {
+				existing.Directions = append(existing.Directions, Direction{Name: direction.Headsign, Stops: direction.Stops})
+			}
+		}
+		dataset.Lines[key] = existing
+	}
+
+	for _, direction := range line.Directions {
+		known := false
+		for _, d := range existing.Directions {
+			if d.Name == direction.Name {
+				known = true
+				break
+			}
+		}
+		if !known {
+			existing.Directions = append(existing.Directions, Direction{Name: direction.Name})
+		}
+	}
+}
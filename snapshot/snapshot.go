@@ -0,0 +1,140 @@
+// Package snapshot crawls the stops and lines of the network into a single
+// serializable dataset. Datasets serve as the source for offline features and
+// for research, and can be refreshed incrementally.
+//
+// Example usage:
+//
+//	feed, err := gtfs.Download(ctx, gtfs.DefaultFeedURL)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	crawler := &snapshot.Crawler{Client: client, Feed: feed, MaxAge: 7 * 24 * time.Hour}
+//	dataset, err := crawler.Crawl(ctx, nil, previous)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	err = dataset.SaveFile("network.json")
+package snapshot
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/niclaszll/dvb-go"
+)
+
+// Dataset is a snapshot of the network's stops and lines
+type Dataset struct {
+	// Created is when the dataset was last crawled
+	Created time.Time `json:"created"`
+
+	// Stops are keyed by DVB API stop ID
+	Stops map[string]*Stop `json:"stops"`
+
+	// Lines are keyed by line key, see LineKey
+	Lines map[string]*Line `json:"lines"`
+}
+
+// Stop is a single stop of the network
+type Stop struct {
+	Id    string `json:"id"`
+	Name  string `json:"name"`
+	Place string `json:"place,omitempty"`
+
+	// X and Y are the Gauss-Krüger zone 4 coordinates (northing and easting), 0 if unknown
+	X int `json:"x,omitempty"`
+	Y int `json:"y,omitempty"`
+
+	// Lines are the keys of the lines serving the stop
+	Lines []string `json:"lines"`
+
+	// Crawled is when the stop was last crawled
+	Crawled time.Time `json:"crawled"`
+}
+
+// Line is a single line of the network
+type Line struct {
+	Name string      `json:"name"`
+	Mot  dvb.MotType `json:"mot"`
+
+	// Diva is the DIVA number identifying the line across the network, if known
+	Diva string `json:"diva,omitempty"`
+
+	Directions []Direction `json:"directions"`
+}
+
+// Direction is the stop sequence of a line towards one destination
+type Direction struct {
+	Name string `json:"name"`
+
+	// Stops are the DVB API stop IDs in travel order; empty if unknown
+	Stops []string `json:"stops,omitempty"`
+}
+
+// LineKey identifies a line by mode of transport and name, as line names are
+// only unique per mode of transport
+func LineKey(mot dvb.MotType, name string) string {
+	return string(mot) + ":" + name
+}
+
+// New creates an empty dataset
+func New() *Dataset {
+	return &Dataset{
+		Stops: make(map[string]*Stop),
+		Lines: make(map[string]*Line),
+	}
+}
+
+// ReadJSON decodes a dataset written by WriteJSON
+func ReadJSON(r io.Reader) (*Dataset, error) {
+	dataset := New()
+	if err := json.NewDecoder(r).Decode(dataset); err != nil {
+		return nil, fmt.Errorf("failed to decode dataset: %w", err)
+	}
+	if dataset.Stops == nil {
+		dataset.Stops = make(map[string]*Stop)
+	}
+	if dataset.Lines == nil {
+		dataset.Lines = make(map[string]*Line)
+	}
+	return dataset, nil
+}
+
+// WriteJSON encodes the dataset as indented JSON
+func (d *Dataset) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(d)
+}
+
+// LoadFile reads a dataset from a JSON file
+func LoadFile(path string) (*Dataset, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return ReadJSON(file)
+}
+
+// SaveFile atomically writes the dataset to a JSON file
+func (d *Dataset) SaveFile(path string) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return fmt.Errorf("failed to create dataset file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := d.WriteJSON(tmp); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write dataset: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write dataset: %w", err)
+	}
+	return os.Rename(tmp.Name(), path)
+}
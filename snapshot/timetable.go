@@ -0,0 +1,285 @@
+package snapshot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/niclaszll/dvb-go"
+	"github.com/niclaszll/dvb-go/coords"
+	"github.com/niclaszll/dvb-go/gtfs"
+)
+
+// TimetableCrawler builds a minimal GTFS static feed from the API by collecting
+// the departures of stops over a time window and the stop sequences of their
+// trips. The feed covers a single service day and can be written with
+// gtfs.Feed.WriteZip for offline analysis or GTFS tooling.
+//
+// Every stop takes several requests, so configure a rate limit on the client
+// (dvb.Config.RateLimit); Bulk.RateLimit only limits the stops started per second.
+//
+// Example usage:
+//
+//	crawler := &snapshot.TimetableCrawler{Client: client, From: start, Window: 2 * time.Hour}
+//	feed, err := crawler.Crawl(ctx, []string{"33000028", "33000037"})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	err = feed.SaveFile("vvo-snapshot.zip")
+type TimetableCrawler struct {
+	// Client is used for all requests. This is required.
+	Client dvb.API
+
+	// From is the start of the crawled window (optional, defaults to now)
+	From time.Time
+
+	// Window is the length of the crawled window (optional, defaults to 1h)
+	Window time.Duration
+
+	// Limit is the number of departures requested per MonitorStop call (optional, defaults to 50)
+	Limit int
+
+	// Bulk controls concurrency, rate limiting and progress reporting
+	Bulk dvb.BulkOptions
+
+	// Errors is called for every stop or trip that could not be crawled (optional)
+	Errors func(stopId string, err error)
+}
+
+// timetable collects the crawled trips; it is shared by the workers
+type timetable struct {
+	mu      sync.Mutex
+	feed    *gtfs.Feed
+	day     time.Time
+	service string
+
+	// seen holds the departures already covered by a crawled trip, keyed by
+	// departure ID, stop ID and scheduled time
+	seen map[string]bool
+}
+
+// Crawl collects the departures of the given DVB API stop IDs (e.g. the keys of
+// Dataset.Stops) and returns them as GTFS feed. Stops are identified by their
+// station DHID where possible, with the API stop ID as stop_code.
+func (c *TimetableCrawler) Crawl(ctx context.Context, stopIds []string) (*gtfs.Feed, error) {
+	if c.Client == nil {
+		return nil, errors.New("client can not be nil")
+	}
+	if len(stopIds) == 0 {
+		return nil, errors.New("no stops to crawl")
+	}
+
+	location, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		location = time.Local
+	}
+	from := c.From
+	if from.IsZero() {
+		from = time.Now()
+	}
+	from = from.In(location)
+	window := c.Window
+	if window <= 0 {
+		window = time.Hour
+	}
+
+	day := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, location)
+	t := &timetable{
+		feed:    gtfs.NewFeed(location),
+		day:     day,
+		service: "day_" + day.Format("20060102"),
+		seen:    make(map[string]bool),
+	}
+	t.feed.Agencies["vvo"] = &gtfs.Agency{
+		Id:       "vvo",
+		Name:     "Verkehrsverbund Oberelbe",
+		URL:      "https://www.vvo-online.de",
+		Timezone: location.String(),
+	}
+	t.feed.CalendarDates[t.service] = []gtfs.CalendarDate{{ServiceId: t.service, Date: day, ExceptionType: 1}}
+
+	fetch := func(ctx context.Context, stopId string) (struct{}, error) {
+		return struct{}{}, c.crawlStop(ctx, t, stopId, from, from.Add(window))
+	}
+	handle := func(stopId string, _ struct{}, err error) error {
+		if err != nil && c.Errors != nil {
+			c.Errors(stopId, err)
+		}
+		return nil
+	}
+	if err := dvb.RunBulk(ctx, stopIds, fetch, handle, c.Bulk); err != nil {
+		return nil, err
+	}
+
+	t.feed.Index()
+	return t.feed, nil
+}
+
+// crawlStop pages through the departures of the stop within [from, until) and
+// crawls the trips not covered yet
+func (c *TimetableCrawler) crawlStop(ctx context.Context, t *timetable, stopId string, from, until time.Time) error {
+	limit := c.Limit
+	if limit <= 0 {
+		limit = 50
+	}
+
+	for from.Before(until) {
+		response, err := c.Client.MonitorStop(ctx, &dvb.MonitorStopParams{
+			StopId: stopId,
+			Time:   dvb.TimePtr(from),
+			Limit:  dvb.Int(limit),
+		})
+		if errors.Is(err, dvb.ErrNoDepartures) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get departures: %w", err)
+		}
+
+		next := from
+		for _, departure := range response.Departures {
+			scheduled := departure.ScheduledTime.Time
+			if scheduled.IsZero() || scheduled.Before(from) || !scheduled.Before(until) {
+				continue
+			}
+			if scheduled.After(next) {
+				next = scheduled
+			}
+			if t.covered(departure.Id, stopId, scheduled) {
+				continue
+			}
+			if err := c.crawlTrip(ctx, t, stopId, departure); err != nil && c.Errors != nil {
+				c.Errors(stopId, fmt.Errorf("trip %s at %s: %w", departure.Id, scheduled.Format("15:04"), err))
+			}
+		}
+
+		// Stop paging if the response didn't advance, e.g. because it was empty
+		if !next.After(from) {
+			return nil
+		}
+		from = next.Add(time.Minute)
+	}
+	return nil
+}
+
+// crawlTrip fetches the stop sequence of the departure's trip and adds it to the feed
+func (c *TimetableCrawler) crawlTrip(ctx context.Context, t *timetable, stopId string, departure dvb.Departure) error {
+	details, err := c.Client.GetTripDetails(ctx, &dvb.GetTripDetailsParams{
+		TripId: departure.Id,
+		StopId: stopId,
+		Time:   departure.ScheduledTime.Time,
+	})
+	if err != nil {
+		return err
+	}
+
+	t.add(departure, details.Stops)
+	return nil
+}
+
+// covered reports whether the departure belongs to a trip crawled before
+func (t *timetable) covered(departureId, stopId string, scheduled time.Time) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.seen[seenKey(departureId, stopId, scheduled)]
+}
+
+// add adds the trip with the given stops to the feed
+func (t *timetable) add(departure dvb.Departure, stops []dvb.TripStop) {
+	if len(stops) == 0 {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	routeId := LineKey(departure.Mot, departure.LineName)
+	first := stops[0]
+	tripId := fmt.Sprintf("%s:%s:%s", routeId, first.Id, first.Time.In(t.feed.Location).Format("20060102T1504"))
+	if _, ok := t.feed.Trips[tripId]; ok {
+		return
+	}
+
+	if _, ok := t.feed.Routes[routeId]; !ok {
+		t.feed.Routes[routeId] = &gtfs.Route{
+			Id:        routeId,
+			AgencyId:  "vvo",
+			ShortName: departure.LineName,
+			Type:      routeType(departure.Mot),
+		}
+	}
+	t.feed.Trips[tripId] = &gtfs.Trip{
+		Id:          tripId,
+		RouteId:     routeId,
+		ServiceId:   t.service,
+		Headsign:    departure.Direction,
+		DirectionId: directionId(departure.Id),
+	}
+
+	stopTimes := make([]gtfs.StopTime, 0, len(stops))
+	for i, stop := range stops {
+		gtfsStopId := t.stop(stop)
+		offset := stop.Time.Sub(t.day).Truncate(time.Second)
+		stopTimes = append(stopTimes, gtfs.StopTime{
+			TripId:    tripId,
+			StopId:    gtfsStopId,
+			Arrival:   offset,
+			Departure: offset,
+			Sequence:  i + 1,
+		})
+		t.seen[seenKey(departure.Id, stop.Id, stop.Time.Time)] = true
+	}
+	t.feed.StopTimes[tripId] = stopTimes
+}
+
+// stop adds the trip stop to the feed if needed and returns its GTFS stop ID
+func (t *timetable) stop(stop dvb.TripStop) string {
+	id := stop.Id
+	if dhid, ok := gtfs.DHIDFromStopId(stop.Id); ok {
+		id = dhid
+	}
+	if _, ok := t.feed.Stops[id]; ok {
+		return id
+	}
+
+	gtfsStop := &gtfs.Stop{Id: id, Code: stop.Id, Name: stop.Name}
+	if stop.Latitude != 0 && stop.Longitude != 0 {
+		gtfsStop.Lat, gtfsStop.Lon = coords.GK4ToWGS84(float64(stop.Latitude), float64(stop.Longitude))
+	}
+	t.feed.Stops[id] = gtfsStop
+	return id
+}
+
+// seenKey identifies a departure of a trip at a stop
+func seenKey(departureId, stopId string, scheduled time.Time) string {
+	return departureId + "|" + stopId + "|" + scheduled.Format(time.RFC3339)
+}
+
+// routeType maps the API's mode of transport to the GTFS route type
+func routeType(mot dvb.MotType) int {
+	switch mot {
+	case dvb.MotTram:
+		return 0
+	case dvb.MotSuburbanRailway, dvb.MotTrain:
+		return 2
+	case dvb.MotFerry:
+		return 4
+	case dvb.MotCableway:
+		return 7
+	default:
+		return 3
+	}
+}
+
+// directionId derives the GTFS direction from departure IDs like
+// "voe:11003: :R:j25", where "H" is the outbound and "R" the return direction
+func directionId(departureId string) int {
+	fields := strings.Split(departureId, ":")
+	if len(fields) >= 4 && fields[3] == "R" {
+		return 1
+	}
+	return 0
+}
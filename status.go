@@ -0,0 +1,149 @@
+package dvb
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// Reason is a stable, machine-readable classification of an API status or error.
+// Unlike the API's messages it does not change with wording or language.
+type Reason string
+
+const (
+	ReasonOk                 Reason = "ok"
+	ReasonValidation         Reason = "validation"          // The request parameters were rejected
+	ReasonStopNotFound       Reason = "stop_not_found"      // The stop ID or name is unknown
+	ReasonNoDepartures       Reason = "no_departures"       // The stop has no departures in the requested window
+	ReasonNoRoute            Reason = "no_route"            // The trip planner found no connection
+	ReasonTripNotFound       Reason = "trip_not_found"      // The trip is unknown or no longer running
+	ReasonSameOriginDest     Reason = "same_origin_dest"    // Origin and destination are identical
+	ReasonOutsideTimetable   Reason = "outside_timetable"   // The requested time is outside the timetable period
+	ReasonServiceUnavailable Reason = "service_unavailable" // The API or a backend system is not available
+	ReasonRateLimited        Reason = "rate_limited"        // Too many requests were sent
+	ReasonUnknown            Reason = "unknown"             // The status could not be classified
+)
+
+// reasonEnglish holds the English description of each reason
+var reasonEnglish = map[Reason]string{
+	ReasonOk:                 "The request was successful.",
+	ReasonValidation:         "The request contains invalid parameters.",
+	ReasonStopNotFound:       "The stop could not be found.",
+	ReasonNoDepartures:       "There are no departures from this stop in the requested period.",
+	ReasonNoRoute:            "No connection could be found.",
+	ReasonTripNotFound:       "The trip could not be found.",
+	ReasonSameOriginDest:     "Origin and destination are identical.",
+	ReasonOutsideTimetable:   "The requested time is outside the timetable period.",
+	ReasonServiceUnavailable: "The service is temporarily unavailable. Please try again later.",
+	ReasonRateLimited:        "Too many requests. Please try again later.",
+	ReasonUnknown:            "An unknown error occurred.",
+}
+
+// English returns the English description of the reason
+func (r Reason) English() string {
+	if english, ok := reasonEnglish[r]; ok {
+		return english
+	}
+	return reasonEnglish[ReasonUnknown]
+}
+
+// statusCodeReasons maps the API's Status.Code values to reasons
+var statusCodeReasons = map[string]Reason{
+	statusOk:            ReasonOk,
+	"ValidationError":   ReasonValidation,
+	"ServiceError":      ReasonServiceUnavailable,
+	"NotFound":          ReasonStopNotFound,
+	"NoRoutesFound":     ReasonNoRoute,
+	"NoConnectionFound": ReasonNoRoute,
+}
+
+// statusMessageReasons maps known (mostly German) Status.Message fragments to
+// reasons. They are matched case-insensitively and take precedence over the code,
+// as the API reports different problems with the same code.
+var statusMessageReasons = []struct {
+	fragment string
+	reason   Reason
+}{
+	{"haltestelle nicht gefunden", ReasonStopNotFound},
+	{"haltestelle ist unbekannt", ReasonStopNotFound},
+	{"unbekannte haltestelle", ReasonStopNotFound},
+	{"stop invalid", ReasonStopNotFound},
+	{"keine abfahrten", ReasonNoDepartures},
+	{"keine verbindung", ReasonNoRoute},
+	{"keine route", ReasonNoRoute},
+	{"start und ziel sind identisch", ReasonSameOriginDest},
+	{"start und ziel identisch", ReasonSameOriginDest},
+	{"außerhalb des fahrplan", ReasonOutsideTimetable},
+	{"ausserhalb des fahrplan", ReasonOutsideTimetable},
+	{"nicht verfügbar", ReasonServiceUnavailable},
+	{"nicht erreichbar", ReasonServiceUnavailable},
+	{"zu viele anfragen", ReasonRateLimited},
+	{"ungültig", ReasonValidation},
+	{"is not set", ReasonValidation},
+}
+
+// Reason classifies the status by its message and code
+func (s Status) Reason() Reason {
+	message := strings.ToLower(s.Message)
+	for _, known := range statusMessageReasons {
+		if strings.Contains(message, known.fragment) {
+			return known.reason
+		}
+	}
+	if reason, ok := statusCodeReasons[s.Code]; ok {
+		return reason
+	}
+	if s.Code == "" {
+		return ReasonOk
+	}
+	return ReasonUnknown
+}
+
+// EnglishMessage returns an English description of the status, suitable for
+// showing to users instead of the API's German message.
+func (s Status) EnglishMessage() string {
+	return s.Reason().English()
+}
+
+// ErrorReason classifies an error returned by the client. Returns ReasonOk for
+// nil errors and ReasonUnknown for errors that can't be classified, such as
+// network failures.
+//
+// Example usage:
+//
+//	response, err := client.MonitorStop(ctx, params)
+//	if err != nil {
+//		reason := dvb.ErrorReason(err)
+//		fmt.Println(reason, reason.English())
+//	}
+func ErrorReason(err error) Reason {
+	if err == nil {
+		return ReasonOk
+	}
+
+	var notFound *NotFoundError
+	if errors.As(err, &notFound) {
+		return notFound.Reason()
+	}
+
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.Reason()
+	}
+
+	var apiErr *apiError
+	if errors.As(err, &apiErr) {
+		switch {
+		case apiErr.StatusCode == http.StatusTooManyRequests:
+			return ReasonRateLimited
+		case apiErr.StatusCode >= http.StatusInternalServerError:
+			return ReasonServiceUnavailable
+		case apiErr.StatusCode == http.StatusNotFound:
+			return ReasonStopNotFound
+		case apiErr.StatusCode >= http.StatusBadRequest:
+			return ReasonValidation
+		}
+	}
+
+	return ReasonUnknown
+}
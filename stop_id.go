@@ -0,0 +1,41 @@
+package dvb
+
+import "strings"
+
+// IsStopId reports whether s is a well-formed DVB API stop ID, i.e. consists of
+// digits only like "33000028". It does not check that the stop exists.
+func IsStopId(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// NormalizeStopId extracts the stop ID from common input forms: it trims
+// whitespace, accepts raw point finder results like "33000028|||Hauptbahnhof|..."
+// and strips names appended to the ID like in "33000028 Hauptbahnhof" (e.g. from
+// copied departure board texts). Returns false if no well-formed stop ID is found.
+//
+// Example usage:
+//
+//	stopId, ok := dvb.NormalizeStopId(" 33000028|||Hauptbahnhof|5657516|4621644|0|| ")
+//	// stopId == "33000028", ok == true
+func NormalizeStopId(s string) (string, bool) {
+	s = strings.TrimSpace(s)
+	if id, _, ok := strings.Cut(s, "|"); ok {
+		s = strings.TrimSpace(id)
+	}
+	if id, rest, ok := strings.Cut(s, " "); ok && IsStopId(id) && !IsStopId(strings.Fields(rest)[0]) {
+		s = id
+	}
+
+	if !IsStopId(s) {
+		return "", false
+	}
+	return s, true
+}
@@ -0,0 +1,185 @@
+package dvb
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// StopMonitorParams contains the parameters for continuously monitoring a stop.
+type StopMonitorParams struct {
+	// Stop contains the MonitorStop parameters used for every poll. StopId is required.
+	Stop MonitorStopParams
+
+	// Interval is the time between two polls (optional, defaults to 30s). If the
+	// response's ExpirationTime is later, polling waits until it has passed.
+	Interval time.Duration
+
+	// MaxInterval caps the wait derived from ExpirationTime (optional, defaults to 5m)
+	MaxInterval time.Duration
+}
+
+// StopUpdate is delivered after every poll of the stop
+type StopUpdate struct {
+	// Response is the latest departure board, nil if the poll failed. A board without
+	// departures is delivered as a response with no Departures, not as an error.
+	Response *MonitorStopResponse
+
+	// Events are the changes since the previous successful poll, see DiffDepartures
+	Events []DepartureEvent
+
+	// Err is the error of the poll, if any
+	Err error
+
+	// Time is when the poll finished
+	Time time.Time
+}
+
+// StopMonitor polls MonitorStop for a single stop and delivers updates over a channel,
+// so departure boards don't have to implement their own polling loop.
+//
+// Example usage:
+//
+//	monitor, err := client.NewStopMonitor(dvb.StopMonitorParams{
+//		Stop: dvb.MonitorStopParams{StopId: "33000028"},
+//	})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	if err := monitor.Start(ctx); err != nil {
+//		log.Fatal(err)
+//	}
+//	defer monitor.Stop()
+//	for update := range monitor.Updates() {
+//		if update.Err != nil {
+//			continue
+//		}
+//		render(update.Response.Departures)
+//	}
+type StopMonitor struct {
+	client *Client
+	params StopMonitorParams
+	differ DepartureDiffer
+
+	mu      sync.Mutex
+	cancel  context.CancelFunc
+	done    chan struct{}
+	updates chan StopUpdate
+}
+
+// NewStopMonitor creates a StopMonitor for the given stop. Call Start to begin polling.
+func (c *Client) NewStopMonitor(params StopMonitorParams) (*StopMonitor, error) {
+	if params.Stop.StopId == "" {
+		return nil, errors.New("stopid can not be empty")
+	}
+	if params.Interval <= 0 {
+		params.Interval = 30 * time.Second
+	}
+	if params.MaxInterval <= 0 {
+		params.MaxInterval = 5 * time.Minute
+	}
+
+	return &StopMonitor{
+		client:  c,
+		params:  params,
+		updates: make(chan StopUpdate, 1),
+	}, nil
+}
+
+// Updates returns the channel receiving an update after every poll. It is closed
+// when the monitor stops. If the consumer falls behind, older updates are dropped
+// in favor of the latest one.
+func (m *StopMonitor) Updates() <-chan StopUpdate {
+	return m.updates
+}
+
+// Start begins polling in the background until Stop is called or ctx is cancelled.
+// A monitor can only be started once.
+func (m *StopMonitor) Start(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.done != nil {
+		return errors.New("monitor already started")
+	}
+
+	ctx, m.cancel = context.WithCancel(ctx)
+	m.done = make(chan struct{})
+	m.client.metrics.PollerState(m.name(), true)
+	go m.run(ctx, m.done)
+	return nil
+}
+
+// Stop ends polling and waits for the background goroutine to exit
+func (m *StopMonitor) Stop() {
+	m.mu.Lock()
+	cancel, done := m.cancel, m.done
+	m.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+		<-done
+	}
+}
+
+// name identifies the monitor in metrics
+func (m *StopMonitor) name() string {
+	return "stop:" + m.params.Stop.StopId
+}
+
+func (m *StopMonitor) run(ctx context.Context, done chan struct{}) {
+	defer func() {
+		m.client.metrics.PollerState(m.name(), false)
+		close(m.updates)
+		close(done)
+	}()
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		update := m.poll(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+
+		// Replace a pending update nobody consumed yet with the latest one
+		select {
+		case <-m.updates:
+		default:
+		}
+		m.updates <- update
+
+		timer.Reset(m.wait(update))
+	}
+}
+
+// poll queries the stop once
+func (m *StopMonitor) poll(ctx context.Context) StopUpdate {
+	response, err := m.client.MonitorStop(ctx, &m.params.Stop)
+	now := time.Now()
+	if err != nil {
+		return StopUpdate{Err: err, Time: now}
+	}
+
+	return StopUpdate{
+		Response: response,
+		Events:   m.differ.Update(response.Departures, now),
+		Time:     now,
+	}
+}
+
+// wait returns the time until the next poll, respecting the response's ExpirationTime
+func (m *StopMonitor) wait(update StopUpdate) time.Duration {
+	if update.Response == nil {
+		return m.params.Interval
+	}
+	return min(max(m.params.Interval, update.Response.RefreshAfter()), m.params.MaxInterval)
+}
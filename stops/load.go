@@ -0,0 +1,140 @@
+package stops
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/niclaszll/dvb-go/gtfs"
+)
+
+// csvHeader is the header of the CSV format read by Load and written by WriteCSV
+var csvHeader = []string{"id", "name", "place", "lat", "lon"}
+
+// Load reads a database from CSV with the columns id, name, place, lat and lon
+// and a header row. Coordinates may be empty for stops without a known position.
+func Load(r io.Reader) (*Database, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = len(csvHeader)
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header: %w", err)
+	}
+	for i, column := range csvHeader {
+		if strings.TrimSpace(strings.ToLower(header[i])) != column {
+			return nil, fmt.Errorf("invalid header: expected %s", strings.Join(csvHeader, ","))
+		}
+	}
+
+	var stops []Stop
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read stops: %w", err)
+		}
+
+		stop := Stop{Id: record[0], Name: record[1], Place: record[2]}
+		if stop.Id == "" {
+			line, _ := reader.FieldPos(0)
+			return nil, fmt.Errorf("line %d: id can not be empty", line)
+		}
+		if stop.Latitude, err = parseCoordinate(record[3]); err != nil {
+			return nil, fmt.Errorf("stop %s: invalid latitude: %w", stop.Id, err)
+		}
+		if stop.Longitude, err = parseCoordinate(record[4]); err != nil {
+			return nil, fmt.Errorf("stop %s: invalid longitude: %w", stop.Id, err)
+		}
+		stops = append(stops, stop)
+	}
+	return New(stops), nil
+}
+
+// LoadFile reads a database from the CSV file at path, see Load
+func LoadFile(path string) (*Database, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open stops: %w", err)
+	}
+	defer file.Close()
+
+	return Load(file)
+}
+
+func parseCoordinate(value string) (float64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	return strconv.ParseFloat(value, 64)
+}
+
+// WriteCSV writes the database in the format read by Load, e.g. to store a
+// dataset built with FromFeed
+func (db *Database) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, stop := range db.stops {
+		record := []string{stop.Id, stop.Name, stop.Place, "", ""}
+		if stop.Latitude != 0 || stop.Longitude != 0 {
+			record[3] = strconv.FormatFloat(stop.Latitude, 'f', 6, 64)
+			record[4] = strconv.FormatFloat(stop.Longitude, 'f', 6, 64)
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// FromFeed builds a database of the stations with a DVB API stop ID in a GTFS
+// feed. Names of the form "Place, Name" are split into place and name. Stations
+// without coordinates are placed at the center of their platforms.
+//
+// Example usage:
+//
+//	feed, err := gtfs.LoadFile("vvo.zip")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	stops.SetDefault(stops.FromFeed(feed))
+func FromFeed(feed *gtfs.Feed) *Database {
+	var result []Stop
+	for _, stopId := range feed.APIStopIds() {
+		station := feed.StopByAPIId(stopId)
+		stop := Stop{
+			Id:        stopId,
+			Name:      station.Name,
+			Latitude:  station.Lat,
+			Longitude: station.Lon,
+		}
+		if place, name, ok := strings.Cut(station.Name, ","); ok {
+			stop.Place, stop.Name = strings.TrimSpace(place), strings.TrimSpace(name)
+		}
+
+		if stop.Latitude == 0 && stop.Longitude == 0 {
+			var n float64
+			for _, platform := range feed.Platforms(station.Id) {
+				if platform.Lat != 0 || platform.Lon != 0 {
+					stop.Latitude += platform.Lat
+					stop.Longitude += platform.Lon
+					n++
+				}
+			}
+			if n > 0 {
+				stop.Latitude, stop.Longitude = stop.Latitude/n, stop.Longitude/n
+			}
+		}
+		result = append(result, stop)
+	}
+	return New(result)
+}
@@ -0,0 +1,261 @@
+// Package stops resolves VVO stops offline from a static dataset of stop IDs,
+// names, places and coordinates, so apps can search stops by name or position
+// without a network round-trip to the point finder.
+//
+// The package bundles a small dataset of central Dresden stops, which the
+// package-level functions search by default. Load a complete dataset, e.g. built
+// from the VVO GTFS feed with FromFeed and stored with WriteCSV, and install it
+// with SetDefault.
+//
+// Example usage:
+//
+//	for _, stop := range stops.Find("helmholtzstr") {
+//		fmt.Println(stop.Id, stop.Name)
+//	}
+//	if nearest := stops.Nearest(51.0405, 13.7318); len(nearest) > 0 {
+//		fmt.Printf("%s (%.0fm)\n", nearest[0].Name, nearest[0].Distance)
+//	}
+package stops
+
+import (
+	"bytes"
+	_ "embed"
+	"math"
+	"sort"
+	"strings"
+	"sync/atomic"
+)
+
+// Stop is a stop of the dataset
+type Stop struct {
+	// Id is the DVB API stop ID, e.g. "33000028"
+	Id string
+
+	// Name is the name of the stop, e.g. "Hauptbahnhof"
+	Name string
+
+	// Place is the city or area of the stop, e.g. "Dresden"
+	Place string
+
+	// Latitude and Longitude are the WGS84 coordinates of the stop
+	Latitude  float64
+	Longitude float64
+}
+
+// StopDistance is a stop along with its distance from a searched position
+type StopDistance struct {
+	Stop
+
+	// Distance is the great-circle distance in meters between the position and the stop
+	Distance float64
+}
+
+// Database is an immutable, searchable set of stops. It is safe for concurrent use.
+type Database struct {
+	stops []Stop
+	byId  map[string]int
+
+	// fullNames and names hold the normalized "place name" and name of every stop,
+	// see normalize
+	fullNames []string
+	names     []string
+}
+
+// New creates a database of stops. Later stops replace earlier ones with the same ID.
+func New(stops []Stop) *Database {
+	db := &Database{byId: make(map[string]int, len(stops))}
+	for _, stop := range stops {
+		fullName, name := normalize(stop.Place+" "+stop.Name), normalize(stop.Name)
+		if i, ok := db.byId[stop.Id]; ok {
+			db.stops[i], db.fullNames[i], db.names[i] = stop, fullName, name
+			continue
+		}
+		db.byId[stop.Id] = len(db.stops)
+		db.stops = append(db.stops, stop)
+		db.fullNames = append(db.fullNames, fullName)
+		db.names = append(db.names, name)
+	}
+	return db
+}
+
+// Len returns the number of stops in the database
+func (db *Database) Len() int {
+	return len(db.stops)
+}
+
+// All returns all stops in the database
+func (db *Database) All() []Stop {
+	return append([]Stop(nil), db.stops...)
+}
+
+// Get returns the stop with the given DVB API stop ID
+func (db *Database) Get(stopId string) (Stop, bool) {
+	i, ok := db.byId[stopId]
+	if !ok {
+		return Stop{}, false
+	}
+	return db.stops[i], true
+}
+
+// Find returns the stops whose place and name contain every word of query, best
+// matches first. Matching ignores case, umlauts and common abbreviations, so
+// "helmholtzstr" finds "Helmholtzstraße" and "dresden hbf" finds "Hauptbahnhof"
+// in Dresden.
+func (db *Database) Find(query string) []Stop {
+	words := strings.Fields(normalize(query))
+	if len(words) == 0 {
+		return nil
+	}
+
+	type match struct {
+		index int
+		rank  int
+	}
+	var matches []match
+	for i, fullName := range db.fullNames {
+		rank, ok := rankMatch(fullName, db.names[i], words)
+		if ok {
+			matches = append(matches, match{index: i, rank: rank})
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		a, b := db.stops[matches[i].index], db.stops[matches[j].index]
+		if matches[i].rank != matches[j].rank {
+			return matches[i].rank < matches[j].rank
+		}
+		if len(a.Name) != len(b.Name) {
+			return len(a.Name) < len(b.Name)
+		}
+		return a.Name < b.Name
+	})
+
+	result := make([]Stop, len(matches))
+	for i, m := range matches {
+		result[i] = db.stops[m.index]
+	}
+	return result
+}
+
+// rankMatch reports whether fullName (normalized place and name) contains all
+// words, and ranks the match: 0 if the name equals the query, 1 if the name starts
+// with it, 2 if every word starts a word of fullName and 3 otherwise
+func rankMatch(fullName, name string, words []string) (int, bool) {
+	rank := 2
+	for _, word := range words {
+		if !strings.Contains(fullName, word) {
+			return 0, false
+		}
+		if !strings.HasPrefix(fullName, word) && !strings.Contains(fullName, " "+word) {
+			rank = 3
+		}
+	}
+
+	query := strings.Join(words, " ")
+	switch {
+	case name == query:
+		return 0, true
+	case strings.HasPrefix(name, query):
+		return 1, true
+	}
+	return rank, true
+}
+
+// Nearest returns the stops ordered by their distance to a WGS84 position, nearest
+// first. Stops without coordinates are skipped.
+func (db *Database) Nearest(lat, lon float64) []StopDistance {
+	var result []StopDistance
+	for _, stop := range db.stops {
+		if stop.Latitude == 0 && stop.Longitude == 0 {
+			continue
+		}
+		result = append(result, StopDistance{
+			Stop:     stop,
+			Distance: haversine(lat, lon, stop.Latitude, stop.Longitude),
+		})
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Distance < result[j].Distance
+	})
+	return result
+}
+
+// haversine returns the great-circle distance in meters between two coordinates
+func haversine(lat1, lon1, lat2, lon2 float64) float64 {
+	const earthRadius = 6371000
+	phi1, phi2 := lat1*math.Pi/180, lat2*math.Pi/180
+	dLat := phi2 - phi1
+	dLon := (lon2 - lon1) * math.Pi / 180
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(phi1)*math.Cos(phi2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadius * math.Asin(math.Sqrt(h))
+}
+
+// normalize folds case, umlauts, punctuation and common abbreviations of stop
+// names, so queries match regardless of spelling
+func normalize(s string) string {
+	s = strings.ToLower(s)
+	s = strings.NewReplacer(
+		"ä", "ae", "ö", "oe", "ü", "ue", "ß", "ss",
+		".", " ", ",", " ", "-", " ", "/", " ", "(", " ", ")", " ",
+	).Replace(s)
+
+	words := strings.Fields(s)
+	for i, word := range words {
+		switch {
+		case strings.HasSuffix(word, "strasse"):
+			word = strings.TrimSuffix(word, "strasse") + "str"
+		case word == "strasse":
+			word = "str"
+		case word == "hbf":
+			word = "hauptbahnhof"
+		case word == "bf":
+			word = "bahnhof"
+		case word == "pl":
+			word = "platz"
+		}
+		words[i] = word
+	}
+	return strings.Join(words, " ")
+}
+
+//go:embed stops.csv
+var bundled []byte
+
+// defaultDatabase is the database searched by the package-level functions
+var defaultDatabase atomic.Pointer[Database]
+
+func init() {
+	db, err := Load(bytes.NewReader(bundled))
+	if err != nil {
+		panic("stops: invalid bundled dataset: " + err.Error())
+	}
+	defaultDatabase.Store(db)
+}
+
+// Default returns the database searched by the package-level functions
+func Default() *Database {
+	return defaultDatabase.Load()
+}
+
+// SetDefault replaces the database searched by the package-level functions, e.g.
+// with a complete dataset loaded on startup
+func SetDefault(db *Database) {
+	defaultDatabase.Store(db)
+}
+
+// Get returns the stop with the given DVB API stop ID from the default database
+func Get(stopId string) (Stop, bool) {
+	return Default().Get(stopId)
+}
+
+// Find searches the default database by name, see Database.Find
+func Find(query string) []Stop {
+	return Default().Find(query)
+}
+
+// Nearest returns the stops of the default database nearest to a WGS84 position,
+// see Database.Nearest
+func Nearest(lat, lon float64) []StopDistance {
+	return Default().Nearest(lat, lon)
+}
@@ -0,0 +1,124 @@
+package dvb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/niclaszll/dvb-go/coords"
+)
+
+// FindStopsNearParams contains the parameters for searching stops around a position.
+type FindStopsNearParams struct {
+	// Latitude and Longitude are the WGS84 position to search around in degrees,
+	// e.g. from a phone's location service. These are required.
+	Latitude  float64
+	Longitude float64
+
+	// Radius is the maximum distance of returned stops in meters.
+	// Optional parameter, defaults to 1000.
+	Radius *float64
+
+	// Limit restricts the maximum number of stops returned.
+	// Optional parameter, defaults to 10.
+	Limit *int
+}
+
+// StopDistance is a stop along with its distance from the searched position
+type StopDistance struct {
+	Point Point
+
+	// Distance is the straight-line distance in meters between the position and the stop
+	Distance float64
+}
+
+// FindStopsNear returns the stops around a WGS84 position, nearest first. The
+// position is converted to the Gauss-Krüger coordinates the API expects and sent
+// as a point finder "coord:" query, so callers don't need to know its syntax.
+//
+// Parameters:
+//   - ctx: Context for the request, allowing for cancellation and timeouts
+//   - options: Parameters including the required position and optional radius and limit
+//   - requestOpts: Optional per-request overrides like headers or a timeout, see RequestOption
+//
+// Returns:
+//   - []StopDistance: The stops within the radius sorted by distance, empty if there are none
+//   - error: Returns an error if the position is invalid or if the API request fails
+//
+// Example usage:
+//
+//	stops, err := client.FindStopsNear(ctx, &FindStopsNearParams{
+//		Latitude:  51.0405,
+//		Longitude: 13.7318,
+//	})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, stop := range stops {
+//		fmt.Printf("%s (%.0fm)\n", stop.Point.Name, stop.Distance)
+//	}
+func (c *Client) FindStopsNear(ctx context.Context, options *FindStopsNearParams, requestOpts ...RequestOption) ([]StopDistance, error) {
+	ctx, cancel := withRequestOptions(ctx, requestOpts)
+	defer cancel()
+
+	if options == nil {
+		return nil, errors.New("latitude and longitude can not be empty")
+	}
+	query, x, y, err := coordQuery(options.Latitude, options.Longitude)
+	if err != nil {
+		return nil, err
+	}
+
+	radius := 1000.0
+	if options.Radius != nil && *options.Radius > 0 {
+		radius = *options.Radius
+	}
+	limit := 10
+	if options.Limit != nil && *options.Limit > 0 {
+		limit = *options.Limit
+	}
+
+	response, err := c.GetPoint(ctx, &GetPointParams{
+		Query:     query,
+		StopsOnly: Bool(true),
+		Limit:     Int(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var stops []StopDistance
+	for _, point := range response.Points.OnlyStops() {
+		if point.X == 0 || point.Y == 0 {
+			continue
+		}
+		distance := math.Hypot(float64(point.X)-x, float64(point.Y)-y)
+		if distance <= radius {
+			stops = append(stops, StopDistance{Point: point, Distance: distance})
+		}
+	}
+
+	sort.SliceStable(stops, func(i, j int) bool {
+		return stops[i].Distance < stops[j].Distance
+	})
+	if len(stops) > limit {
+		stops = stops[:limit]
+	}
+	return stops, nil
+}
+
+// coordQuery returns the point finder query for a WGS84 position along with the
+// position's Gauss-Krüger coordinates
+func coordQuery(lat, lon float64) (query string, x, y float64, err error) {
+	if lat == 0 && lon == 0 {
+		return "", 0, 0, errors.New("latitude and longitude can not be empty")
+	}
+	if math.Abs(lat) > 90 || math.Abs(lon) > 180 {
+		return "", 0, 0, fmt.Errorf("invalid position %f, %f", lat, lon)
+	}
+
+	x, y = coords.WGS84ToGK4(lat, lon)
+	return fmt.Sprintf("coord:%d:%d", int(math.Round(x)), int(math.Round(y))), x, y, nil
+}
@@ -0,0 +1,193 @@
+package dvb
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// suggestFetchLimit is the number of points requested per prefix. Fetching more
+	// than shown lets longer prefixes be answered from a shorter prefix's results.
+	suggestFetchLimit = 25
+
+	// suggestCacheSize is the number of prefixes kept in the suggestion cache
+	suggestCacheSize = 256
+
+	// suggestTTL is how long suggestions are cached if the response carries no
+	// ExpirationTime
+	suggestTTL = 10 * time.Minute
+)
+
+// Suggestion is a lightweight point finder result for search boxes
+type Suggestion struct {
+	// Id is the identifier to pass to other API calls, e.g. the stop ID for MonitorStop
+	Id string
+
+	// Name is the display name of the point
+	Name string
+
+	// Place is the city or area of the point, may be empty for points in Dresden
+	Place string
+
+	// Type classifies the point as stop, address or POI
+	Type PointType
+}
+
+// Suggest returns up to n suggestions for a search prefix as the user types, best
+// matches first (see Points.Rank). Results are cached per prefix, and a longer
+// prefix is answered from the cached results of a shorter one without a request
+// if those were complete, so calling Suggest on every keystroke is cheap.
+//
+// Callers should cancel the ctx of a pending call when the next keystroke
+// arrives; a cancelled call does not affect the cache.
+//
+// Parameters:
+//   - ctx: Context for the request, allowing for cancellation and timeouts
+//   - prefix: The text typed so far. No suggestions are returned for an empty prefix.
+//   - n: The maximum number of suggestions (defaults to 10 if not positive)
+//   - requestOpts: Optional per-request overrides like headers or a timeout, see RequestOption
+//
+// Returns:
+//   - []Suggestion: The suggestions, empty if nothing matches
+//   - error: Returns an error if the API request fails
+//
+// Example usage:
+//
+//	suggestions, err := client.Suggest(ctx, "helmh", 5)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, suggestion := range suggestions {
+//		fmt.Println(suggestion.Id, suggestion.Name, suggestion.Place)
+//	}
+func (c *Client) Suggest(ctx context.Context, prefix string, n int, requestOpts ...RequestOption) ([]Suggestion, error) {
+	if n <= 0 {
+		n = 10
+	}
+	key := strings.Join(matchWords(prefix), " ")
+	if key == "" {
+		return nil, nil
+	}
+
+	points, ok := c.suggestions.get(key)
+	if !ok {
+		response, err := c.GetPoint(ctx, &GetPointParams{
+			Query: strings.TrimSpace(prefix),
+			Limit: Int(suggestFetchLimit),
+		}, requestOpts...)
+		if err != nil {
+			return nil, err
+		}
+
+		points = response.Points
+		expires := response.ExpiresAt()
+		if !expires.After(time.Now()) {
+			expires = time.Now().Add(suggestTTL)
+		}
+		c.suggestions.set(key, points, len(points) < suggestFetchLimit, expires)
+	}
+
+	points = points.Rank(prefix)
+	suggestions := make([]Suggestion, 0, min(n, len(points)))
+	for _, point := range points {
+		if len(suggestions) == n {
+			break
+		}
+		if point.Type == PointUnknown || point.Type == PointCoordinate {
+			continue
+		}
+		suggestions = append(suggestions, Suggestion{
+			Id:    point.Id,
+			Name:  point.Name,
+			Place: point.Place,
+			Type:  point.Type,
+		})
+	}
+	return suggestions, nil
+}
+
+// suggestCache holds the point finder results per normalized prefix
+type suggestCache struct {
+	mu      sync.Mutex
+	entries map[string]*suggestEntry
+}
+
+type suggestEntry struct {
+	points Points
+
+	// complete is true if the API returned fewer points than requested, so the
+	// points contain all matches of longer prefixes as well
+	complete bool
+
+	expires time.Time
+}
+
+func newSuggestCache() *suggestCache {
+	return &suggestCache{entries: make(map[string]*suggestEntry)}
+}
+
+// get returns the points of key, or the points of the longest complete shorter
+// prefix of key that match key
+func (s *suggestCache) get(key string) (Points, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if entry, ok := s.entries[key]; ok && now.Before(entry.expires) {
+		return entry.points, true
+	}
+
+	for end := len(key) - 1; end > 0; end-- {
+		entry, ok := s.entries[key[:end]]
+		if !ok || !entry.complete || !now.Before(entry.expires) {
+			continue
+		}
+
+		words := strings.Fields(key)
+		var points Points
+		for _, point := range entry.points {
+			if matchesPrefix(point, words) {
+				points = append(points, point)
+			}
+		}
+		return points, true
+	}
+	return nil, false
+}
+
+// set stores the points of key, evicting the entry expiring soonest if the cache is full
+func (s *suggestCache) set(key string, points Points, complete bool, expires time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.entries[key]; !ok && len(s.entries) >= suggestCacheSize {
+		var soonest string
+		for k, entry := range s.entries {
+			if soonest == "" || entry.expires.Before(s.entries[soonest].expires) {
+				soonest = k
+			}
+		}
+		delete(s.entries, soonest)
+	}
+	s.entries[key] = &suggestEntry{points: points, complete: complete, expires: expires}
+}
+
+// matchesPrefix reports whether every word starts a word of the point's place or name
+func matchesPrefix(point Point, words []string) bool {
+	pointWords := append(matchWords(point.Place), matchWords(point.Name)...)
+	for _, word := range words {
+		found := false
+		for _, pointWord := range pointWords {
+			if strings.HasPrefix(pointWord, word) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
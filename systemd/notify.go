@@ -0,0 +1,89 @@
+// Package systemd implements the parts of the systemd service protocol needed by
+// long-running dvb services: readiness and status notifications via sd_notify and
+// watchdog keep-alives. All functions are no-ops when not running under systemd.
+package systemd
+
+import (
+	"context"
+	"errors"
+	"net"
+	"os"
+	"strconv"
+	"time"
+)
+
+// Notification states understood by systemd
+const (
+	Ready     = "READY=1"
+	Stopping  = "STOPPING=1"
+	Reloading = "RELOADING=1"
+	Watchdog  = "WATCHDOG=1"
+)
+
+// ErrNotSupported is returned if the process was not started by systemd with a notify socket
+var ErrNotSupported = errors.New("systemd notify socket not available")
+
+// Notify sends a state string (e.g. Ready, or "STATUS=polling 3 stops") to the
+// service manager. It returns ErrNotSupported if NOTIFY_SOCKET is not set.
+func Notify(state string) error {
+	socket := os.Getenv("NOTIFY_SOCKET")
+	if socket == "" {
+		return ErrNotSupported
+	}
+
+	// Abstract namespace sockets are announced with a leading "@"
+	if socket[0] == '@' {
+		socket = "\x00" + socket[1:]
+	}
+
+	conn, err := net.DialUnix("unixgram", nil, &net.UnixAddr{Name: socket, Net: "unixgram"})
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = conn.Write([]byte(state))
+	return err
+}
+
+// Status sends a human-readable status line shown by systemctl status
+func Status(status string) error {
+	return Notify("STATUS=" + status)
+}
+
+// WatchdogInterval returns the interval at which keep-alives must be sent, or
+// false if the watchdog is not enabled for this process
+func WatchdogInterval() (time.Duration, bool) {
+	usec, err := strconv.ParseInt(os.Getenv("WATCHDOG_USEC"), 10, 64)
+	if err != nil || usec <= 0 {
+		return 0, false
+	}
+	if pid := os.Getenv("WATCHDOG_PID"); pid != "" && pid != strconv.Itoa(os.Getpid()) {
+		return 0, false
+	}
+	return time.Duration(usec) * time.Microsecond, true
+}
+
+// RunWatchdog sends keep-alives at half the configured watchdog interval while
+// healthy returns nil, until ctx is cancelled. It returns immediately if the
+// watchdog is not enabled. If healthy is nil, the process is always considered healthy.
+func RunWatchdog(ctx context.Context, healthy func() error) {
+	interval, ok := WatchdogInterval()
+	if !ok {
+		return
+	}
+
+	ticker := time.NewTicker(interval / 2)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if healthy == nil || healthy() == nil {
+				Notify(Watchdog)
+			}
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package dvb
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	// Embed the time zone database, so Europe/Berlin is available in minimal
+	// container images (scratch, distroless) without tzdata installed
+	_ "time/tzdata"
+)
+
+// berlin is the time zone of the API's timestamps
+var berlin = mustLoadLocation("Europe/Berlin")
+
+func mustLoadLocation(name string) *time.Location {
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		panic(fmt.Sprintf("failed to load time zone %s: %v", name, err))
+	}
+	return location
+}
+
+// ParseDate parses the API's timestamp format "/Date(1629715680000+0200)/"
+// (milliseconds since the Unix epoch followed by a UTC offset) into a time.Time
+// in Europe/Berlin.
+func ParseDate(s string) (time.Time, error) {
+	if !strings.HasPrefix(s, "/Date(") || !strings.HasSuffix(s, ")/") {
+		return time.Time{}, fmt.Errorf("invalid date format: %q", s)
+	}
+	value := strings.TrimSuffix(strings.TrimPrefix(s, "/Date("), ")/")
+
+	// The offset is optional and only affects the zone the time is presented in,
+	// which is always Europe/Berlin
+	if i := strings.LastIndexAny(value, "+-"); i > 0 {
+		value = value[:i]
+	}
+
+	ms, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date value: %w", err)
+	}
+	return time.UnixMilli(ms).In(berlin), nil
+}
+
+// formatQueryTime formats t for the time parameter of requests: RFC 3339 in
+// Europe/Berlin, the zone the API interprets times without offset in
+func formatQueryTime(t time.Time) string {
+	return t.In(berlin).Format(time.RFC3339)
+}
+
+// firstDate returns the first non-zero timestamp of the given candidates,
+// skipping nil values, or the zero time if all are unknown.
+func firstDate(candidates ...*Time) time.Time {
+	for _, candidate := range candidates {
+		if candidate != nil && !candidate.IsZero() {
+			return candidate.Time
+		}
+	}
+	return time.Time{}
+}
+
+// FormatDate formats t in the API's timestamp format "/Date(1629715680000+0200)/",
+// using the UTC offset of t's location.
+func FormatDate(t time.Time) string {
+	_, offset := t.Zone()
+	sign := '+'
+	if offset < 0 {
+		sign, offset = '-', -offset
+	}
+	return fmt.Sprintf("/Date(%d%c%02d%02d)/", t.UnixMilli(), sign, offset/3600, offset%3600/60)
+}
+
+// Time is a timestamp in the API's "/Date(1629715680000+0200)/" format. It embeds
+// time.Time in Europe/Berlin, so all time.Time methods can be used directly.
+// Missing or empty timestamps decode to the zero time.
+type Time struct {
+	time.Time
+}
+
+// UnmarshalJSON decodes a timestamp in the API's format
+func (t *Time) UnmarshalJSON(data []byte) error {
+	var s *string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("invalid date: %w", err)
+	}
+	if s == nil || *s == "" {
+		*t = Time{}
+		return nil
+	}
+
+	parsed, err := ParseDate(*s)
+	if err != nil {
+		return err
+	}
+	*t = Time{parsed}
+	return nil
+}
+
+// MarshalJSON encodes the timestamp in the API's format, or null if it is zero
+func (t Time) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(FormatDate(t.Time))
+}
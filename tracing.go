@@ -0,0 +1,48 @@
+package dvb
+
+import (
+	"context"
+	"net/http"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName identifies the spans created by this package
+const tracerName = "github.com/niclaszll/dvb-go"
+
+// startSpan starts a client span for an API call if tracing is configured. The
+// returned function ends the span with the outcome of the call.
+func (c *Client) startSpan(ctx context.Context, opts requestOptions) (context.Context, func(*http.Response, error)) {
+	if c.tracer == nil {
+		return ctx, func(*http.Response, error) {}
+	}
+
+	attributes := []attribute.KeyValue{
+		attribute.String("dvb.endpoint", opts.Path),
+		attribute.String("http.request.method", opts.Method),
+	}
+	if stopId := opts.Query.Get("stopid"); stopId != "" {
+		attributes = append(attributes, attribute.String("dvb.stop_id", stopId))
+	}
+
+	ctx, span := c.tracer.Start(ctx, "dvb "+opts.Path,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attributes...),
+	)
+
+	return ctx, func(resp *http.Response, err error) {
+		switch {
+		case err != nil:
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		case resp != nil:
+			span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+			if resp.StatusCode >= http.StatusBadRequest {
+				span.SetStatus(codes.Error, http.StatusText(resp.StatusCode))
+			}
+		}
+		span.End()
+	}
+}
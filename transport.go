@@ -0,0 +1,104 @@
+package dvb
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// TransportOptions tunes the transport of the default HTTP client. The defaults
+// keep more idle connections per host than http.DefaultTransport, so services
+// sending many concurrent requests to the API reuse connections instead of
+// exhausting ephemeral ports. All options are ignored if Config.HTTPClient is set.
+type TransportOptions struct {
+	// MaxIdleConns is the maximum number of idle connections across all hosts (optional, defaults to 100)
+	MaxIdleConns int
+
+	// MaxIdleConnsPerHost is the maximum number of idle connections kept per host (optional, defaults to 32)
+	MaxIdleConnsPerHost int
+
+	// MaxConnsPerHost limits the number of connections per host, including
+	// connections in use; requests beyond it wait (optional, unlimited if 0)
+	MaxConnsPerHost int
+
+	// IdleConnTimeout is how long an idle connection is kept open (optional, defaults to 90s)
+	IdleConnTimeout time.Duration
+
+	// DialTimeout bounds establishing a connection (optional, defaults to 10s)
+	DialTimeout time.Duration
+
+	// KeepAlive is the interval of TCP keep-alive probes (optional, defaults to 30s)
+	KeepAlive time.Duration
+
+	// TLSHandshakeTimeout bounds the TLS handshake (optional, defaults to 10s)
+	TLSHandshakeTimeout time.Duration
+
+	// TLSConfig configures TLS, e.g. custom root CAs or client certificates for a
+	// gateway (optional, defaults to the system configuration)
+	TLSConfig *tls.Config
+
+	// ProxyURL routes all requests through this HTTP or SOCKS5 proxy (optional,
+	// defaults to the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables)
+	ProxyURL *url.URL
+}
+
+// withDefaults returns the options with unset values replaced by their defaults
+func (o TransportOptions) withDefaults() TransportOptions {
+	if o.MaxIdleConns == 0 {
+		o.MaxIdleConns = 100
+	}
+	if o.MaxIdleConnsPerHost == 0 {
+		o.MaxIdleConnsPerHost = 32
+	}
+	if o.IdleConnTimeout == 0 {
+		o.IdleConnTimeout = 90 * time.Second
+	}
+	if o.DialTimeout == 0 {
+		o.DialTimeout = 10 * time.Second
+	}
+	if o.KeepAlive == 0 {
+		o.KeepAlive = 30 * time.Second
+	}
+	if o.TLSHandshakeTimeout == 0 {
+		o.TLSHandshakeTimeout = 10 * time.Second
+	}
+	return o
+}
+
+// newTransport builds the transport of the default HTTP client from the
+// configured transport options and dialer
+func newTransport(config Config) *http.Transport {
+	options := config.Transport.withDefaults()
+	dialer := &net.Dialer{
+		Timeout:   options.DialTimeout,
+		KeepAlive: options.KeepAlive,
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = dialer.DialContext
+	transport.MaxIdleConns = options.MaxIdleConns
+	transport.MaxIdleConnsPerHost = options.MaxIdleConnsPerHost
+	transport.MaxConnsPerHost = options.MaxConnsPerHost
+	transport.IdleConnTimeout = options.IdleConnTimeout
+	transport.TLSHandshakeTimeout = options.TLSHandshakeTimeout
+	if options.TLSConfig != nil {
+		transport.TLSClientConfig = options.TLSConfig.Clone()
+	}
+	if options.ProxyURL != nil {
+		transport.Proxy = http.ProxyURL(options.ProxyURL)
+	}
+
+	if config.DialContext != nil {
+		transport.DialContext = config.DialContext
+	}
+	if config.UnixSocket != "" {
+		socket := config.UnixSocket
+		transport.DialContext = func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return dialer.DialContext(ctx, "unix", socket)
+		}
+	}
+	return transport
+}